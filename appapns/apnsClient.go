@@ -0,0 +1,201 @@
+package appapns
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"io"
+	"net/http"
+	"os"
+	"sol.go/cwm/apppush"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type APNS_PUSH_TYPE string
+type APNS_PRIORITY int32
+
+const (
+	APNS_HOST_PRODUCTION  = "https://api.push.apple.com"
+	APNS_HOST_DEVELOPMENT = "https://api.sandbox.push.apple.com"
+
+	APNS_PUSH_TYPE_ALERT      APNS_PUSH_TYPE = "alert"
+	APNS_PUSH_TYPE_BACKGROUND APNS_PUSH_TYPE = "background"
+	APNS_PUSH_TYPE_VOIP       APNS_PUSH_TYPE = "voip"
+
+	APNS_PRIORITY_NORMAL APNS_PRIORITY = 5 //required for background push
+	APNS_PRIORITY_HIGH   APNS_PRIORITY = 10
+
+	APNS_REASON_BAD_DEVICE_TOKEN = "BadDeviceToken"
+	APNS_REASON_UNREGISTERED     = "Unregistered"
+
+	authTokenTTL = 50 * time.Minute //APNs rejects tokens older than 1 hour, and refreshing more than once per 20 mins
+)
+
+var (
+	BadDeviceTokenErr = fmt.Errorf("APNs - BadDeviceToken: %w", apppush.InvalidTokenErr)
+	UnregisteredErr   = fmt.Errorf("APNs - Unregistered: %w", apppush.InvalidTokenErr)
+)
+
+// APNsClient sends a notification to APNs, HTTP2Client talks to Apple, a mock server can stand in via the host
+type APNsClient interface {
+	Push(ctx context.Context, notification *Notification) (*Response, error)
+}
+
+type Notification struct {
+	DeviceToken string
+	Topic       string //bundleId, bundleId.voip for voip push
+	PushType    APNS_PUSH_TYPE
+	Priority    APNS_PRIORITY
+	Expiration  time.Time //zero - APNs does not store the notification
+	CollapseID  string
+	Payload     []byte
+}
+
+type Response struct {
+	StatusCode int
+	ApnsID     string
+	Reason     string
+	Timestamp  int64 //ms, set with Unregistered - the last time APNs confirmed the token was valid
+}
+
+type AuthToken struct {
+	KeyID      string
+	TeamID     string
+	privateKey *ecdsa.PrivateKey
+
+	lock     sync.Mutex
+	bearer   string
+	issuedAt time.Time
+}
+
+type HTTP2Client struct {
+	Host       string
+	HTTPClient *http.Client
+	AuthToken  *AuthToken
+}
+
+// NewAuthTokenFromFile loads the .p8 signing key of the token-based auth
+func NewAuthTokenFromFile(p8File, keyID, teamID string) (*AuthToken, error) {
+	keyData, err := os.ReadFile(p8File)
+	if err != nil {
+		return nil, fmt.Errorf("(APNs - NewAuthTokenFromFile): failed reading p8 file -> %w", err)
+	}
+
+	privateKey, err := jwt.ParseECPrivateKeyFromPEM(keyData)
+	if err != nil {
+		return nil, fmt.Errorf("(APNs - NewAuthTokenFromFile): failed parsing p8 key -> %w", err)
+	}
+
+	return &AuthToken{
+		KeyID:      keyID,
+		TeamID:     teamID,
+		privateKey: privateKey,
+	}, nil
+}
+
+// Bearer returns the cached provider token, or signs a new one when it is about to expire
+func (t *AuthToken) Bearer() (string, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(t.bearer) > 0 && time.Since(t.issuedAt) < authTokenTTL {
+		return t.bearer, nil
+	}
+
+	issuedAt := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": t.TeamID,
+		"iat": issuedAt.Unix(),
+	})
+	token.Header["kid"] = t.KeyID
+
+	bearer, err := token.SignedString(t.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("(APNs - Bearer): failed signing token -> %w", err)
+	}
+
+	t.bearer = bearer
+	t.issuedAt = issuedAt
+	return bearer, nil
+}
+
+func NewHTTP2Client(host string, authToken *AuthToken) *HTTP2Client {
+	return &HTTP2Client{
+		Host: host,
+		HTTPClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig:   &tls.Config{MinVersion: tls.VersionTLS12},
+				ForceAttemptHTTP2: true, //APNs only speaks HTTP/2
+				IdleConnTimeout:   5 * time.Minute,
+			},
+			Timeout: 30 * time.Second,
+		},
+		AuthToken: authToken,
+	}
+}
+
+func (c *HTTP2Client) Push(ctx context.Context, notification *Notification) (*Response, error) {
+	url := fmt.Sprintf("%v/3/device/%v", c.Host, notification.DeviceToken)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(notification.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("(APNs - Push): failed creating request -> %w", err)
+	}
+
+	bearer, err := c.AuthToken.Bearer()
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("authorization", "bearer "+bearer)
+	httpReq.Header.Set("content-type", "application/json")
+	httpReq.Header.Set("apns-topic", notification.Topic)
+	httpReq.Header.Set("apns-push-type", string(notification.PushType))
+	httpReq.Header.Set("apns-priority", strconv.Itoa(int(notification.Priority)))
+	if !notification.Expiration.IsZero() {
+		httpReq.Header.Set("apns-expiration", strconv.FormatInt(notification.Expiration.Unix(), 10))
+	}
+	if len(notification.CollapseID) > 0 {
+		httpReq.Header.Set("apns-collapse-id", notification.CollapseID)
+	}
+
+	httpRes, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("(APNs - Push): failed executing request -> %w", err)
+	}
+	defer httpRes.Body.Close()
+
+	response := &Response{
+		StatusCode: httpRes.StatusCode,
+		ApnsID:     httpRes.Header.Get("apns-id"),
+	}
+
+	if httpRes.StatusCode == http.StatusOK {
+		return response, nil
+	}
+
+	body := struct {
+		Reason    string `json:"reason"`
+		Timestamp int64  `json:"timestamp"`
+	}{}
+	bodyData, _ := io.ReadAll(httpRes.Body)
+	_ = json.Unmarshal(bodyData, &body)
+	response.Reason = body.Reason
+	response.Timestamp = body.Timestamp
+
+	switch {
+	case httpRes.StatusCode == http.StatusGone || response.Reason == APNS_REASON_UNREGISTERED:
+		return response, UnregisteredErr
+	case response.Reason == APNS_REASON_BAD_DEVICE_TOKEN:
+		return response, BadDeviceTokenErr
+	case httpRes.StatusCode == http.StatusTooManyRequests || httpRes.StatusCode >= http.StatusInternalServerError:
+		return response, fmt.Errorf("APNs - push failed: %v - %v: %w", httpRes.StatusCode, response.Reason, apppush.RetryableErr)
+	default:
+		return response, fmt.Errorf("APNs - push failed: %v - %v", httpRes.StatusCode, response.Reason)
+	}
+}
@@ -0,0 +1,154 @@
+package appapns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sol.go/cwm/apppush"
+	"time"
+)
+
+const (
+	voipTopicSuffix   = ".voip"
+	defaultExpiration = 24 * 60 * 60 //seconds
+)
+
+// AppAPNs is the APNs PushProvider
+type AppAPNs struct {
+	Client       APNsClient
+	DefaultTopic string
+}
+
+var (
+	APNsNotConfiguredErr = errors.New("APNs is not configured")
+)
+
+// NewAppAPNs inits the APNs client from APNS_KEY_FILE (.p8), APNS_KEY_ID, APNS_TEAM_ID, APNS_HOST and APNS_TOPIC
+func NewAppAPNs() (*AppAPNs, error) {
+	fmt.Println("Init App APNs...")
+
+	keyFile := os.Getenv("APNS_KEY_FILE")
+	if len(keyFile) == 0 {
+		return nil, APNsNotConfiguredErr
+	}
+
+	authToken, err := NewAuthTokenFromFile(keyFile, os.Getenv("APNS_KEY_ID"), os.Getenv("APNS_TEAM_ID"))
+	if err != nil {
+		return nil, err
+	}
+
+	host := os.Getenv("APNS_HOST")
+	if len(host) == 0 {
+		host = APNS_HOST_PRODUCTION
+	}
+
+	return &AppAPNs{
+		Client:       NewHTTP2Client(host, authToken),
+		DefaultTopic: os.Getenv("APNS_TOPIC"),
+	}, nil
+}
+
+func (appAPNs *AppAPNs) Name() string {
+	return apppush.PUSH_PROVIDER_APNS
+}
+
+func (appAPNs *AppAPNs) Send(ctx context.Context, pushMsg *apppush.PushMsg) []apppush.PushResult {
+	results := []apppush.PushResult{}
+
+	for _, target := range pushMsg.Targets {
+		payload, pushType, priority, err := buildPayload(pushMsg, target.Badge)
+		if err != nil {
+			log.Println("sendAPNsMsg - build payload failed", err)
+			results = append(results, apppush.PushResult{Target: target, Err: err})
+			continue
+		}
+
+		topic := target.BundleId
+		if len(topic) == 0 {
+			topic = appAPNs.DefaultTopic
+		}
+		if pushMsg.VoIP {
+			topic += voipTopicSuffix
+		}
+
+		notification := &Notification{
+			DeviceToken: target.Token,
+			Topic:       topic,
+			PushType:    pushType,
+			Priority:    priority,
+			Payload:     payload,
+			CollapseID:  pushMsg.CollapseKey,
+		}
+		if pushType != APNS_PUSH_TYPE_VOIP {
+			ttl := pushMsg.TTL
+			if ttl <= 0 {
+				ttl = defaultExpiration
+			}
+			notification.Expiration = time.Now().Add(time.Duration(ttl) * time.Second)
+		}
+
+		_, err = appAPNs.Client.Push(ctx, notification)
+		if err != nil {
+			log.Printf("sendAPNsMsg to %v failed: %v\n", target.Token, err)
+		}
+
+		results = append(results, apppush.PushResult{
+			Target: target,
+			Err:    err,
+		})
+	}
+
+	return results
+}
+
+// buildPayload - high priority msgs and msgs with a notification are alert pushes (mutable-content, so the Notification Service Extension can decode the msg)
+// showing the rendered notification, grouped by thread, with the badge of the user. Normal priority msgs are silent background pushes
+func buildPayload(pushMsg *apppush.PushMsg, badge *int64) ([]byte, APNS_PUSH_TYPE, APNS_PRIORITY, error) {
+	aps := map[string]interface{}{}
+	pushType := APNS_PUSH_TYPE_BACKGROUND
+	priority := APNS_PRIORITY_NORMAL
+
+	if pushMsg.VoIP {
+		pushType = APNS_PUSH_TYPE_VOIP
+		priority = APNS_PRIORITY_HIGH
+	} else if pushMsg.PriorityLevel == apppush.PUSH_PRIORITY_HIGH || pushMsg.Notification != nil {
+		pushType = APNS_PUSH_TYPE_ALERT
+		if pushMsg.PriorityLevel == apppush.PUSH_PRIORITY_HIGH {
+			priority = APNS_PRIORITY_HIGH
+		}
+		body := pushMsg.Body
+		if len(body) == 0 {
+			body = "New message"
+		}
+		alert := map[string]string{
+			"body": body,
+		}
+		if len(pushMsg.Title) > 0 {
+			alert["title"] = pushMsg.Title
+		}
+		aps["alert"] = alert
+		if pushMsg.Notification != nil {
+			aps["thread-id"] = pushMsg.Notification.ThreadId
+		}
+		aps["sound"] = "default"
+		if badge != nil {
+			aps["badge"] = *badge
+		}
+		aps["mutable-content"] = 1
+	} else {
+		aps["content-available"] = 1
+	}
+
+	payloadMap := map[string]interface{}{
+		"aps": aps,
+	}
+	for key, value := range pushMsg.Data() {
+		payloadMap[key] = value
+	}
+
+	payload, err := json.Marshal(payloadMap)
+	return payload, pushType, priority, err
+}
@@ -0,0 +1,260 @@
+package appapns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sol.go/cwm/apppush"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type mockAPNsRequest struct {
+	Path    string
+	Proto   string
+	Header  http.Header
+	Payload map[string]interface{}
+}
+
+// mockAPNsServer stands in for APNs over HTTP/2, the tokens of reasons are rejected with their reason
+type mockAPNsServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	requests []mockAPNsRequest
+	reasons  map[string]int
+}
+
+func newMockAPNsServer(t *testing.T) *mockAPNsServer {
+	mock := &mockAPNsServer{
+		reasons: map[string]int{},
+	}
+
+	mock.Server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		payload := map[string]interface{}{}
+		_ = json.Unmarshal(body, &payload)
+
+		mock.lock.Lock()
+		mock.requests = append(mock.requests, mockAPNsRequest{
+			Path:    r.URL.Path,
+			Proto:   r.Proto,
+			Header:  r.Header.Clone(),
+			Payload: payload,
+		})
+		mock.lock.Unlock()
+
+		token := strings.TrimPrefix(r.URL.Path, "/3/device/")
+		for reason, statusCode := range mock.reasons {
+			if strings.HasPrefix(token, reason) {
+				w.WriteHeader(statusCode)
+				_, _ = w.Write([]byte(`{"reason":"` + reason + `"}`))
+				return
+			}
+		}
+		w.Header().Set("apns-id", "apns-"+token)
+		w.WriteHeader(http.StatusOK)
+	}))
+	mock.EnableHTTP2 = true
+	mock.StartTLS()
+	t.Cleanup(mock.Close)
+
+	return mock
+}
+
+func (mock *mockAPNsServer) lastRequest(t *testing.T) mockAPNsRequest {
+	mock.lock.Lock()
+	defer mock.lock.Unlock()
+
+	if len(mock.requests) == 0 {
+		t.Fatal("APNs mock received no request")
+	}
+	return mock.requests[len(mock.requests)-1]
+}
+
+func newTestAppAPNs(t *testing.T, mock *mockAPNsServer) *AppAPNs {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewHTTP2Client(mock.URL, &AuthToken{KeyID: "KEYID", TeamID: "TEAMID", privateKey: privateKey})
+	client.HTTPClient = mock.Client()
+
+	return &AppAPNs{
+		Client:       client,
+		DefaultTopic: "vn.sol.cwm",
+	}
+}
+
+func TestSendAlertPush(t *testing.T) {
+	mock := newMockAPNsServer(t)
+	appAPNs := newTestAppAPNs(t, mock)
+
+	badge := int64(3)
+	pushMsg := &apppush.PushMsg{
+		Targets:       []apppush.PushTarget{{PhoneFull: "+84900000001", SessionId: "s1", Token: "remote-token", Badge: &badge}},
+		DataBase64:    "bXNn",
+		PriorityLevel: apppush.PUSH_PRIORITY_HIGH,
+		Notification:  &apppush.PushNotification{ThreadId: "thread-1"},
+		CollapseKey:   "thread-1",
+		Title:         "Alice",
+		Body:          "Hello",
+	}
+
+	results := appAPNs.Send(context.Background(), pushMsg)
+	if len(results) != 1 || results[0].Err != nil {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+
+	req := mock.lastRequest(t)
+	if req.Proto != "HTTP/2.0" {
+		t.Errorf("proto = %v, want HTTP/2.0", req.Proto)
+	}
+	if req.Path != "/3/device/remote-token" {
+		t.Errorf("path = %v", req.Path)
+	}
+	if got := req.Header.Get("apns-topic"); got != "vn.sol.cwm" {
+		t.Errorf("apns-topic = %v", got)
+	}
+	if got := req.Header.Get("apns-push-type"); got != string(APNS_PUSH_TYPE_ALERT) {
+		t.Errorf("apns-push-type = %v", got)
+	}
+	if got := req.Header.Get("apns-priority"); got != "10" {
+		t.Errorf("apns-priority = %v", got)
+	}
+	if got := req.Header.Get("apns-collapse-id"); got != "thread-1" {
+		t.Errorf("apns-collapse-id = %v", got)
+	}
+	if len(req.Header.Get("apns-expiration")) == 0 {
+		t.Error("apns-expiration is not set")
+	}
+	if !strings.HasPrefix(req.Header.Get("authorization"), "bearer ") {
+		t.Errorf("authorization = %v", req.Header.Get("authorization"))
+	}
+
+	aps, _ := req.Payload["aps"].(map[string]interface{})
+	alert, _ := aps["alert"].(map[string]interface{})
+	if alert["title"] != "Alice" || alert["body"] != "Hello" {
+		t.Errorf("alert = %v", alert)
+	}
+	if aps["badge"] != float64(3) || aps["thread-id"] != "thread-1" || aps["mutable-content"] != float64(1) {
+		t.Errorf("aps = %v", aps)
+	}
+	if req.Payload[apppush.DataMsgKey] != "bXNn" {
+		t.Errorf("msg = %v", req.Payload[apppush.DataMsgKey])
+	}
+}
+
+func TestSendBackgroundPush(t *testing.T) {
+	mock := newMockAPNsServer(t)
+	appAPNs := newTestAppAPNs(t, mock)
+
+	pushMsg := &apppush.PushMsg{
+		Targets:       []apppush.PushTarget{{Token: "remote-token", BundleId: "vn.sol.cwm.beta"}},
+		DataBase64:    "bXNn",
+		PriorityLevel: apppush.PUSH_PRIORITY_NORMAL,
+	}
+
+	results := appAPNs.Send(context.Background(), pushMsg)
+	if len(results) != 1 || results[0].Err != nil {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+
+	req := mock.lastRequest(t)
+	if got := req.Header.Get("apns-topic"); got != "vn.sol.cwm.beta" {
+		t.Errorf("apns-topic = %v", got)
+	}
+	if got := req.Header.Get("apns-push-type"); got != string(APNS_PUSH_TYPE_BACKGROUND) {
+		t.Errorf("apns-push-type = %v", got)
+	}
+	if got := req.Header.Get("apns-priority"); got != "5" {
+		t.Errorf("apns-priority = %v", got)
+	}
+	aps, _ := req.Payload["aps"].(map[string]interface{})
+	if aps["content-available"] != float64(1) || aps["alert"] != nil {
+		t.Errorf("aps = %v", aps)
+	}
+}
+
+func TestSendVoIPPush(t *testing.T) {
+	mock := newMockAPNsServer(t)
+	appAPNs := newTestAppAPNs(t, mock)
+
+	pushMsg := &apppush.PushMsg{
+		Targets:       []apppush.PushTarget{{Token: "voip-token", VoIP: true}},
+		DataBase64:    "bXNn",
+		PriorityLevel: apppush.PUSH_PRIORITY_NORMAL,
+		VoIP:          true,
+	}
+
+	results := appAPNs.Send(context.Background(), pushMsg)
+	if len(results) != 1 || results[0].Err != nil {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+
+	req := mock.lastRequest(t)
+	if req.Path != "/3/device/voip-token" {
+		t.Errorf("path = %v", req.Path)
+	}
+	if got := req.Header.Get("apns-topic"); got != "vn.sol.cwm.voip" {
+		t.Errorf("apns-topic = %v", got)
+	}
+	if got := req.Header.Get("apns-push-type"); got != string(APNS_PUSH_TYPE_VOIP) {
+		t.Errorf("apns-push-type = %v", got)
+	}
+	if got := req.Header.Get("apns-priority"); got != "10" {
+		t.Errorf("apns-priority = %v", got)
+	}
+	if len(req.Header.Get("apns-expiration")) > 0 {
+		t.Errorf("apns-expiration = %v, VoIP pushes are not stored", req.Header.Get("apns-expiration"))
+	}
+}
+
+func TestSendPushErrors(t *testing.T) {
+	mock := newMockAPNsServer(t)
+	mock.reasons[APNS_REASON_BAD_DEVICE_TOKEN] = http.StatusBadRequest
+	mock.reasons[APNS_REASON_UNREGISTERED] = http.StatusGone
+	mock.reasons["ServiceUnavailable"] = http.StatusServiceUnavailable
+	mock.reasons["BadTopic"] = http.StatusBadRequest
+	appAPNs := newTestAppAPNs(t, mock)
+
+	pushMsg := &apppush.PushMsg{
+		Targets: []apppush.PushTarget{
+			{Token: APNS_REASON_BAD_DEVICE_TOKEN},
+			{Token: APNS_REASON_UNREGISTERED},
+			{Token: "ServiceUnavailable"},
+			{Token: "BadTopic"},
+			{Token: "valid-token"},
+		},
+		DataBase64: "bXNn",
+	}
+
+	results := appAPNs.Send(context.Background(), pushMsg)
+	if len(results) != len(pushMsg.Targets) {
+		t.Fatalf("results = %v, want %v", len(results), len(pushMsg.Targets))
+	}
+
+	if !errors.Is(results[0].Err, BadDeviceTokenErr) || !errors.Is(results[0].Err, apppush.InvalidTokenErr) {
+		t.Errorf("BadDeviceToken err = %v", results[0].Err)
+	}
+	if !errors.Is(results[1].Err, UnregisteredErr) || !errors.Is(results[1].Err, apppush.InvalidTokenErr) {
+		t.Errorf("Unregistered err = %v", results[1].Err)
+	}
+	if !apppush.IsRetryable(results[2].Err) || errors.Is(results[2].Err, apppush.InvalidTokenErr) {
+		t.Errorf("ServiceUnavailable err = %v", results[2].Err)
+	}
+	if results[3].Err == nil || apppush.IsRetryable(results[3].Err) || errors.Is(results[3].Err, apppush.InvalidTokenErr) {
+		t.Errorf("BadTopic err = %v", results[3].Err)
+	}
+	if results[4].Err != nil {
+		t.Errorf("valid token err = %v", results[4].Err)
+	}
+}
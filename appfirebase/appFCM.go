@@ -7,116 +7,135 @@ import (
 	"firebase.google.com/go/v4/messaging"
 	"fmt"
 	"log"
-	"sync"
+	"sol.go/cwm/apppush"
+	"time"
 )
 
-type FCM_PRIORITY int32
-
 const (
-	FCM_PRIORITY_NORMAL FCM_PRIORITY = 0
-	FCM_PRIORITY_HIGH   FCM_PRIORITY = 1
-
-	DataMsgKey = "msg"
+	maxBatchTokens = 500 //FCM APIs allow up to 500 device registration tokens per invocation
 )
 
+// AppFireBase is the FCM PushProvider
 type AppFireBase struct {
-	App               firebase.App
-	SendFCMMsgChannel chan *FCMSendMsg
-}
-
-type FCMSendMsg struct {
-	Tokens        []string
-	DataBase64    string
-	PriorityLevel FCM_PRIORITY
+	App firebase.App
 }
 
 var (
 	InvalidOSTypeErr = errors.New("Invalid OS TYPE")
-	singletonAppFCM  *AppFireBase
-	onceAppFCM       sync.Once
 )
 
-func GetAppFireBase() *AppFireBase {
-	onceAppFCM.Do(func() {
-		fmt.Println("Init App Firebase...")
-		app, err := firebase.NewApp(context.Background(), nil)
-		if err != nil {
-			log.Fatalf("error initializing app firebase: %v\n", err)
-		}
+// NewAppFireBase inits the firebase app with GOOGLE_APPLICATION_CREDENTIALS
+func NewAppFireBase() (*AppFireBase, error) {
+	fmt.Println("Init App Firebase...")
+	app, err := firebase.NewApp(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing app firebase: %w", err)
+	}
 
-		singletonAppFCM = &AppFireBase{
-			App: *app,
-		}
-	})
-	return singletonAppFCM
+	return &AppFireBase{
+		App: *app,
+	}, nil
 }
 
-func (appFirebase *AppFireBase) Start() {
-	sendFCMMsgChannel := make(chan *FCMSendMsg, 1000) //make a buffered channel size 1000
-	go func(sendFCMMsgChannel chan *FCMSendMsg) {
-		for fcmSendMsg := range sendFCMMsgChannel {
-			appFirebase.handleSendMsg(fcmSendMsg)
-		}
-	}(sendFCMMsgChannel)
-
-	appFirebase.SendFCMMsgChannel = sendFCMMsgChannel
+func (appFirebase *AppFireBase) Name() string {
+	return apppush.PUSH_PROVIDER_FCM
 }
 
-func (appFirebase *AppFireBase) RequestSendMsg(fcmSendMsg *FCMSendMsg) {
-	appFirebase.SendFCMMsgChannel <- fcmSendMsg
-}
+func (appFirebase *AppFireBase) Send(ctx context.Context, pushMsg *apppush.PushMsg) []apppush.PushResult {
+	results := []apppush.PushResult{}
+
+	if len(pushMsg.Targets) == 1 {
+		target := pushMsg.Targets[0]
+		err := appFirebase.sendSingleMsg(ctx, target.Token, pushMsg)
+		return append(results, apppush.PushResult{
+			Target: target,
+			Err:    wrapSendErr(err),
+		})
+	}
 
-func (appFirebase *AppFireBase) handleSendMsg(fcmSendMsg *FCMSendMsg) error {
-	if len(fcmSendMsg.Tokens) == 1 {
-		tokenID := fcmSendMsg.Tokens[0]
-		err := appFirebase.sendSingleMsg(tokenID, fcmSendMsg.DataBase64, fcmSendMsg.PriorityLevel)
-		if err != nil {
-			return err
+	for start := 0; start < len(pushMsg.Targets); start += maxBatchTokens {
+		end := start + maxBatchTokens
+		if end > len(pushMsg.Targets) {
+			end = len(pushMsg.Targets)
 		}
+		targets := pushMsg.Targets[start:end]
 
-	} else {
 		tokens := []string{}
-		for _, tokenID := range fcmSendMsg.Tokens {
-			tokens = append(tokens, tokenID)
-
-			if len(tokens) == 500 { //FCM APIs allow up to 500 device registration tokens per invocation
-				appFirebase.sendBatchMsg(tokens, fcmSendMsg.DataBase64, fcmSendMsg.PriorityLevel)
-				tokens = []string{}
-			}
+		for _, target := range targets {
+			tokens = append(tokens, target.Token)
 		}
 
-		if len(tokens) > 0 {
-			appFirebase.sendBatchMsg(tokens, fcmSendMsg.DataBase64, fcmSendMsg.PriorityLevel)
+		batchResponse, err := appFirebase.sendBatchMsg(ctx, tokens, pushMsg)
+		for i, target := range targets {
+			result := apppush.PushResult{
+				Target: target,
+				Err:    wrapSendErr(err),
+			}
+			if err == nil && i < len(batchResponse.Responses) {
+				result.Err = wrapSendErr(batchResponse.Responses[i].Error)
+			}
+			results = append(results, result)
 		}
-
 	}
 
-	return nil
+	return results
 }
 
-func (appFirebase *AppFireBase) sendSingleMsg(token string, dataBase64 string, priorityLevel FCM_PRIORITY) error {
-	// Obtain a messaging.Client from the App.
-	ctx := context.Background()
-	client, err := appFirebase.App.Messaging(ctx)
-	if err != nil {
-		return err
+// wrapSendErr marks the errors of dead tokens with apppush.InvalidTokenErr, so they are pruned from the sessions,
+// and the transient errors with apppush.RetryableErr, so they are retried
+func wrapSendErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if messaging.IsUnregistered(err) || messaging.IsSenderIDMismatch(err) {
+		return fmt.Errorf("%w: %v", apppush.InvalidTokenErr, err)
+	}
+	if messaging.IsUnavailable(err) || messaging.IsInternal(err) || messaging.IsQuotaExceeded(err) {
+		return fmt.Errorf("%w: %v", apppush.RetryableErr, err)
 	}
+	return err
+}
 
+// androidConfig - a msg with a rendered notification is shown by the OS even if the app is killed,
+// notifications with the same collapse key replace each other in the tray and while queued on FCM
+func androidConfig(pushMsg *apppush.PushMsg) *messaging.AndroidConfig {
 	priority := "normal"
-	if priorityLevel == FCM_PRIORITY_HIGH {
+	if pushMsg.PriorityLevel == apppush.PUSH_PRIORITY_HIGH {
 		priority = "high"
 	}
 
 	androidConfig := &messaging.AndroidConfig{
-		//CollapseKey:           "",
-		Priority: priority, // one of "normal" or "high"
+		CollapseKey: pushMsg.CollapseKey,
+		Priority:    priority, // one of "normal" or "high"
+	}
+
+	if pushMsg.TTL > 0 {
+		ttl := time.Duration(pushMsg.TTL) * time.Second
+		androidConfig.TTL = &ttl
+	}
+
+	if len(pushMsg.Title) > 0 || len(pushMsg.Body) > 0 {
+		androidConfig.Notification = &messaging.AndroidNotification{
+			Title: pushMsg.Title,
+			Body:  pushMsg.Body,
+			Tag:   pushMsg.CollapseKey,
+		}
+	}
+
+	return androidConfig
+}
+
+func (appFirebase *AppFireBase) sendSingleMsg(ctx context.Context, token string, pushMsg *apppush.PushMsg) error {
+	// Obtain a messaging.Client from the App.
+	client, err := appFirebase.App.Messaging(ctx)
+	if err != nil {
+		return err
 	}
 
 	message := &messaging.Message{
-		Data: map[string]string{
-			DataMsgKey: dataBase64,
-		},
-		Android: androidConfig,
+		Data:    pushMsg.Data(),
+		Android: androidConfig(pushMsg),
 		Token:   token,
 	}
 
@@ -129,36 +148,23 @@ func (appFirebase *AppFireBase) sendSingleMsg(token string, dataBase64 string, p
 	if err != nil {
 		log.Println("sendFCMMsg failed", err)
 	} else {
-		log.Printf("sendFCMMsg success to %v - priorityLevel: %v", token, priorityLevel)
+		log.Printf("sendFCMMsg success to %v - priorityLevel: %v", token, pushMsg.PriorityLevel)
 	}
 
 	return err
 }
 
-func (appFirebase *AppFireBase) sendBatchMsg(tokens []string, dataBase64 string, priorityLevel FCM_PRIORITY) (*messaging.BatchResponse, error) {
+func (appFirebase *AppFireBase) sendBatchMsg(ctx context.Context, tokens []string, pushMsg *apppush.PushMsg) (*messaging.BatchResponse, error) {
 	// Obtain a messaging.Client from the App.
-	ctx := context.Background()
 	client, err := appFirebase.App.Messaging(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	priority := "normal"
-	if priorityLevel == FCM_PRIORITY_HIGH {
-		priority = "high"
-	}
-
-	androidConfig := &messaging.AndroidConfig{
-		//CollapseKey:           "",
-		Priority: priority, // one of "normal" or "high"
-	}
-
 	// See documentation on defining a message payload.
 	message := &messaging.MulticastMessage{
-		Data: map[string]string{
-			DataMsgKey: dataBase64,
-		},
-		Android: androidConfig,
+		Data:    pushMsg.Data(),
+		Android: androidConfig(pushMsg),
 		Tokens:  tokens,
 	}
 
@@ -170,7 +176,7 @@ func (appFirebase *AppFireBase) sendBatchMsg(tokens []string, dataBase64 string,
 	if err != nil {
 		log.Println("sendFCMMsg failed", err)
 	} else {
-		log.Printf("SendBatchMsg - success %v - failed %v - of all %v - priorityLevel: %v", batchResponse.SuccessCount, batchResponse.FailureCount, len(tokens), priorityLevel)
+		log.Printf("SendBatchMsg - success %v - failed %v - of all %v - priorityLevel: %v", batchResponse.SuccessCount, batchResponse.FailureCount, len(tokens), pushMsg.PriorityLevel)
 	}
 
 	return batchResponse, err
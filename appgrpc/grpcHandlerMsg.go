@@ -1,31 +1,36 @@
 package appgrpc
 
 import (
-	"bytes"
 	"context"
-	"crypto/md5"
+	"encoding/base64"
+	"errors"
 	"fmt"
-	"github.com/gabriel-vasile/mimetype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"io"
 	"log"
+	"sol.go/cwm/appupload"
 	"sol.go/cwm/appws"
+	"sol.go/cwm/blobstore"
 	"sol.go/cwm/dao"
 	"sol.go/cwm/model"
 	"sol.go/cwm/proto/cwmSIPPb"
 	"sol.go/cwm/proto/cwmSignalMsgPb"
 	"sol.go/cwm/proto/grpcCWMPb"
-	"sol.go/cwm/s3Handler"
 	"sol.go/cwm/sip"
-	"sol.go/cwm/static"
 	"sol.go/cwm/utils"
+	"strconv"
 	"strings"
 	"time"
 )
 
+const (
+	downloadChunkSize = 64 * 1024
+)
+
 func (sv *CWMGRPCService) InitialSyncMsg(req *grpcCWMPb.InitialSyncMsgRequest, stream grpcCWMPb.CWMService_InitialSyncMsgServer) error {
 	grpcSession, ok := stream.Context().Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
 	if !ok {
@@ -400,6 +405,14 @@ func (sv *CWMGRPCService) DeleteMsgsOfThread(ctx context.Context, req *grpcCWMPb
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
 	}
 
+	err = sip.DeleteThreadMediaOfMsgs(ctx, msgIds, phoneFulls)
+	if err != nil {
+		log.Printf("DeleteMsgsOfThread - err: %v\n", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	go sip.RecountUnread(context.Background(), threadId, phoneFulls)
+
 	go func(sender *model.User, senderSessionID string, signalThread *model.SignalThread, msgIds []string, deleteForAllMembers bool) {
 		err := sv.SendEventMsgsDelete(
 			sender,
@@ -461,6 +474,14 @@ func (sv *CWMGRPCService) ClearAllMsgOfThread(ctx context.Context, req *grpcCWMP
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
 	}
 
+	err = sip.DeleteThreadMediaOfThread(ctx, threadId, phoneFulls)
+	if err != nil {
+		log.Printf("ClearAllMsgOfThread - err: %v\n", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	go sip.RecountUnread(context.Background(), threadId, phoneFulls)
+
 	go func(sender *model.User, senderSessionID string, signalThread *model.SignalThread, deleteForAllMembers bool) {
 		err := sv.SendEventThreadClearMsg(
 			sender,
@@ -516,6 +537,14 @@ func (sv *CWMGRPCService) DeleteSoloThread(ctx context.Context, req *grpcCWMPb.D
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
 	}
 
+	err = sip.DeleteThreadMediaOfThread(ctx, threadId, phoneFulls)
+	if err != nil {
+		log.Printf("DeleteSoloThread - err: %v\n", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	go sip.RecountUnread(context.Background(), threadId, phoneFulls)
+
 	go func(sender *model.User, senderSessionID string, signalThread *model.SignalThread, deleteForAllMembers bool) {
 		err := sv.SendEventThreadDeleted(
 			sender,
@@ -532,7 +561,42 @@ func (sv *CWMGRPCService) DeleteSoloThread(ctx context.Context, req *grpcCWMPb.D
 	}, nil
 }
 
+func (sv *CWMGRPCService) GetUnreadCount(ctx context.Context, req *grpcCWMPb.GetUnreadCountRequest) (*grpcCWMPb.GetUnreadCountResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("GetUnreadCount - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	counters, err := dao.GetUnreadCounterDAO().FindAllByPhoneFull(ctx, grpcSession.User.PhoneFull)
+	if err != nil {
+		log.Printf("GetUnreadCount - err: %v\n", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	var total int64
+	threadCounts := []*grpcCWMPb.ThreadUnreadCount{}
+	for _, counter := range counters {
+		total += counter.Count
+		threadCounts = append(threadCounts, &grpcCWMPb.ThreadUnreadCount{
+			ThreadId: counter.ThreadId,
+			Count:    counter.Count,
+		})
+	}
+
+	return &grpcCWMPb.GetUnreadCountResponse{
+		Total:        total,
+		ThreadCounts: threadCounts,
+	}, nil
+}
+
 func (sv *CWMGRPCService) UploadMediaMsg(stream grpcCWMPb.CWMService_UploadMediaMsgServer) error {
+	grpcSession, ok := stream.Context().Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("UploadMediaMsg - can not cast GrpcSession")
+		return GRPCInvalidSessionErr
+	}
+
 	req, err := stream.Recv()
 	if err != nil {
 		log.Println(err)
@@ -540,8 +604,25 @@ func (sv *CWMGRPCService) UploadMediaMsg(stream grpcCWMPb.CWMService_UploadMedia
 	}
 
 	mediaMsgInfo := req.GetMediaMsgInfo()
-	fileData := bytes.Buffer{}
-	var fileSize int64 = 0
+	err = appupload.ValidateChecksum(mediaMsgInfo.GetChecksum())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = appupload.BindMediaMsg(stream.Context(), grpcSession.User.PhoneFull, mediaMsgInfo.GetMsgId(), mediaMsgInfo.GetThreadId())
+	if err != nil {
+		return uploadStatusErr(err)
+	}
+
+	storageQuota := appupload.GetStorageQuota()
+	allowance, err := storageQuota.ReserveUpload(stream.Context(), grpcSession.User.PhoneFull, 0)
+	if err != nil {
+		return uploadStatusErr(err)
+	}
+
+	//the chunks are streamed to the BlobStore while they are received
+	upload := appupload.NewStreamUpload(stream.Context(), mediaMsgInfo.GetMediaType(), mediaMsgInfo.GetChecksum())
+	upload.Restrict(allowance)
 
 	for {
 		//log.Println("waiting to receive more media data")
@@ -553,112 +634,52 @@ func (sv *CWMGRPCService) UploadMediaMsg(stream grpcCWMPb.CWMService_UploadMedia
 		}
 		if err != nil {
 			log.Println("Cannot receive chunk data", err)
+			upload.Abort(err)
 			return err
 		}
 
-		chunk := req.GetChunkData()
-		size := len(chunk)
+		//log.Printf("received a chunk with size: %d\n", len(req.GetChunkData()))
 
-		//log.Printf("received a chunk with size: %d\n", size)
-
-		fileSize += int64(size)
-		if fileSize > static.MaxFileSize {
-			return status.Errorf(codes.InvalidArgument, "file is too large: %d > %d", fileSize, static.MaxFileSize)
-		}
-		_, err = fileData.Write(chunk)
+		err = upload.Write(req.GetChunkData())
 		if err != nil {
-			return status.Errorf(codes.Internal, "cannot write chunk data: %v", err)
-		}
-	}
-
-	checksum := fmt.Sprintf("%x", md5.Sum(fileData.Bytes()))
-	if mediaMsgInfo.Checksum != checksum {
-		log.Println("Invalid Checksum", checksum, mediaMsgInfo.Checksum)
-		return status.Errorf(codes.InvalidArgument, "Invalid Checksum: %v - %v", checksum, mediaMsgInfo.Checksum)
-	}
-
-	mimetype := mimetype.Detect(fileData.Bytes())
-	mimetypeString := mimetype.String()
-	if mediaMsgInfo.GetMediaType() == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_IMAGE {
-		if !strings.HasPrefix(mimetypeString, "image") {
-			return status.Errorf(codes.InvalidArgument, "Invalid media image file")
-		}
-	} else if mediaMsgInfo.GetMediaType() == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_VIDEO {
-		if !strings.HasPrefix(mimetypeString, "video") {
-			return status.Errorf(codes.InvalidArgument, "Invalid video file")
-		}
-	} else if mediaMsgInfo.GetMediaType() == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_AUDIO {
-		if !strings.HasPrefix(mimetypeString, "audio") {
-			return status.Errorf(codes.InvalidArgument, "Invalid audio file")
-		}
-	} else if mediaMsgInfo.GetMediaType() == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_DOC {
-		if mimetypeString != "application/pdf" &&
-			mimetypeString != "application/x-pdf" &&
-			mimetypeString != "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet" &&
-			mimetypeString != "application/vnd.openxmlformats-officedocument.wordprocessingml.document" &&
-			mimetypeString != "application/vnd.openxmlformats-officedocument.presentationml.presentation" &&
-			mimetypeString != "application/msword" &&
-			mimetypeString != "application/vnd.ms-word" &&
-			mimetypeString != "application/vnd.ms-powerpoint" &&
-			mimetypeString != "application/mspowerpoint" &&
-			mimetypeString != "application/vnd.ms-excel" &&
-			mimetypeString != "application/msexcel" &&
-			mimetypeString != "application/vnd.oasis.opendocument.text" &&
-			mimetypeString != "application/x-vnd.oasis.opendocument.text" &&
-			mimetypeString != "application/vnd.oasis.opendocument.text-template" &&
-			mimetypeString != "application/x-vnd.oasis.opendocument.text-template" &&
-			mimetypeString != "application/vnd.oasis.opendocument.spreadsheet" &&
-			mimetypeString != "application/x-vnd.oasis.opendocument.spreadsheet" &&
-			mimetypeString != "application/vnd.oasis.opendocument.spreadsheet-template" &&
-			mimetypeString != "application/x-vnd.oasis.opendocument.spreadsheet-template" &&
-			mimetypeString != "application/vnd.oasis.opendocument.presentation" &&
-			mimetypeString != "application/x-vnd.oasis.opendocument.presentation" &&
-			mimetypeString != "application/vnd.oasis.opendocument.presentation-template" &&
-			mimetypeString != "application/x-vnd.oasis.opendocument.presentation-template" {
-			return status.Errorf(codes.InvalidArgument, "Invalid doc file")
+			upload.Abort(err)
+			return uploadStatusErr(err)
 		}
 	}
 
-	//log.Println("mimetype", mimetype.String())
-	//log.Println("extension", mimetype.Extension())
-
-	fileName := fmt.Sprintf("%s%s%s", static.S3NamePrefxix, checksum, mimetype.Extension())
-
-	//upload to S3
-	s3FileStore := s3Handler.GetS3FileStore()
-	uploadOutput, err := s3FileStore.UploadFile(fileName, fileData, mimetype)
+	err = upload.Close()
+	storageQuota.AddDailyBytes(stream.Context(), grpcSession.User.PhoneFull, upload.OriginalFileSize)
 	if err != nil {
-		return status.Errorf(codes.Internal, "cannot save file to the s3: %v", err)
+		return uploadStatusErr(err)
 	}
 
-	log.Println("Done Upload File to S3... etag:", *uploadOutput.ETag)
-
 	s3FileInfo := &model.S3FileInfo{
 		FileId:    utils.GenerateUUID(),
 		MsgId:     mediaMsgInfo.MsgId,
-		FileName:  fileName,
-		FileSize:  fileSize,
-		Checksum:  checksum,
+		ThreadId:  mediaMsgInfo.ThreadId,
+		Uploader:  grpcSession.User.PhoneFull,
+		FileName:  upload.FileName,
+		FileSize:  upload.FileSize,
+		Checksum:  upload.Checksum,
 		MediaType: mediaMsgInfo.MediaType,
 		CreatedAt: time.Now().UnixMilli(),
+
+		OriginalChecksum: upload.OriginalChecksum,
+		OriginalFileSize: upload.OriginalFileSize,
 	}
-	s3FileInfo, err = dao.GetS3FileInfoDAO().Save(context.Background(), s3FileInfo)
+	s3FileInfo, err = appupload.SaveFileInfo(stream.Context(), s3FileInfo, upload.MimeType)
 	if err != nil {
-		return status.Errorf(codes.Internal, "cannot save s3FileInfo: %v", err)
+		return uploadStatusErr(err)
 	}
 
-	//diskFileStore := fileHandler.NewDiskFileStore("media")
-	//savedName, err := diskFileStore.SaveToDisk(mediaMsgInfo.FileName, mediaMsgInfo.FileExtension, fileData)
-	//if err != nil {
-	//	return status.Errorf(codes.Internal, "cannot save image to the store: %v", err)
-	//}
-
 	res := &grpcCWMPb.UploadMediaMsgResponse{
 		FileId:   s3FileInfo.FileId,
 		FileName: s3FileInfo.FileName,
 		FileSize: s3FileInfo.FileSize,
 		CheckSum: s3FileInfo.Checksum,
 		MsgId:    mediaMsgInfo.MsgId,
+
+		OriginalChecksum: s3FileInfo.OriginalChecksum,
 	}
 
 	err = stream.SendAndClose(res)
@@ -666,53 +687,52 @@ func (sv *CWMGRPCService) UploadMediaMsg(stream grpcCWMPb.CWMService_UploadMedia
 		return status.Errorf(codes.Unknown, "cannot send response: %v", err)
 	}
 
-	log.Printf("saved file with name: %s, size: %d, MsgId: %s, FileId: %s, checkSum: %s\n", fileName, fileSize, mediaMsgInfo.MsgId, s3FileInfo.FileId, s3FileInfo.Checksum)
+	log.Printf("saved file with name: %s, size: %d, MsgId: %s, FileId: %s, checkSum: %s\n", s3FileInfo.FileName, s3FileInfo.FileSize, mediaMsgInfo.MsgId, s3FileInfo.FileId, s3FileInfo.Checksum)
 	return nil
 }
 
 func (sv *CWMGRPCService) DownloadMediaMsg(req *grpcCWMPb.DownloadMediaMsgRequest, stream grpcCWMPb.CWMService_DownloadMediaMsgServer) error {
-	_, ok := stream.Context().Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	grpcSession, ok := stream.Context().Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
 	if !ok {
 		log.Println("DownloadMediaMsg - can not cast GrpcSession")
 		return GRPCInvalidSessionErr
 	}
 
-	msgId := req.GetMsgId()
-	fileId := req.GetFileId()
-
-	s3FileInfo, err := dao.GetS3FileInfoDAO().FindByFileId(context.Background(), fileId)
-	if s3FileInfo == nil {
-		log.Println("Not found s3FileInfo", err)
-		return status.Errorf(codes.PermissionDenied, fmt.Sprintf("Not Found s3FileInfo"))
-	}
-
-	if s3FileInfo.MsgId != msgId {
-		log.Println("Invalid msgId")
-		return status.Errorf(codes.PermissionDenied, fmt.Sprintf("Invalid file info"))
+	s3FileInfo, fileName, err := appupload.FindMediaFile(stream.Context(), req.GetMsgId(), req.GetFileId(), req.GetVariant())
+	if err != nil {
+		log.Println("DownloadMediaMsg - not found media", err)
+		return mediaStatusErr(err)
 	}
 
-	s3Object, err := s3Handler.GetS3FileStore().GetObject(s3FileInfo.FileName)
+	err = appupload.AuthorizeMediaAccess(stream.Context(), s3FileInfo, grpcSession.User.PhoneFull)
 	if err != nil {
-		log.Println("Get s3 Object err", err)
-		return status.Errorf(codes.NotFound, fmt.Sprintf("Not found S3 object"))
+		log.Println("DownloadMediaMsg - access denied", grpcSession.User.PhoneFull, err)
+		return mediaStatusErr(err)
 	}
 
-	if s3Object.Body != nil {
-		defer s3Object.Body.Close()
-	}
-	// If there is no content length, it is a directory
-	if s3Object.ContentLength == nil {
-		return nil
+	if req.GetOffset() < 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid offset")
 	}
 
-	//log.Println("DownloadMediaMsg:", *s3Object.ETag, *s3Object.ContentLength)
+	body, _, err := blobstore.GetBlobStore().GetRange(stream.Context(), fileName, req.GetOffset(), -1)
+	if err != nil {
+		log.Println("Get blob err", err)
+		if errors.Is(err, blobstore.BlobNotFoundErr) {
+			return status.Errorf(codes.NotFound, fmt.Sprintf("Not found blob"))
+		}
+		if errors.Is(err, blobstore.InvalidRangeErr) {
+			return status.Errorf(codes.OutOfRange, fmt.Sprintf("Invalid offset: %v", req.GetOffset()))
+		}
+		return status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+	defer body.Close()
 
-	buffer := make([]byte, 1024)
+	buffer := make([]byte, downloadChunkSize)
 	for {
-		n, errRead := s3Object.Body.Read(buffer)
+		n, errRead := body.Read(buffer)
 
 		if errRead != nil && errRead != io.EOF {
-			return status.Errorf(codes.Internal, fmt.Sprintf("Cannot read S3 object"))
+			return status.Errorf(codes.Internal, fmt.Sprintf("Cannot read blob"))
 		}
 
 		if n > 0 {
@@ -736,3 +756,163 @@ func (sv *CWMGRPCService) DownloadMediaMsg(req *grpcCWMPb.DownloadMediaMsgReques
 	//log.Println("DownloadMediaMsg - Done")
 	return nil
 }
+
+func (sv *CWMGRPCService) GetMediaURL(ctx context.Context, req *grpcCWMPb.GetMediaURLRequest) (*grpcCWMPb.GetMediaURLResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("GetMediaURL - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	s3FileInfo, _, err := appupload.FindMediaFile(ctx, req.GetMsgId(), req.GetFileId(), req.GetVariant())
+	if err != nil {
+		log.Println("GetMediaURL - not found media", err)
+		return nil, mediaStatusErr(err)
+	}
+
+	//the signed url is not bound to the user, the access is checked once here
+	err = appupload.AuthorizeMediaAccess(ctx, s3FileInfo, grpcSession.User.PhoneFull)
+	if err != nil {
+		log.Println("GetMediaURL - access denied", grpcSession.User.PhoneFull, err)
+		return nil, mediaStatusErr(err)
+	}
+
+	url, expiresAt := appupload.GetMediaURLSigner().Sign(req.GetMsgId(), req.GetFileId(), req.GetVariant())
+	return &grpcCWMPb.GetMediaURLResponse{
+		Url:       url,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (sv *CWMGRPCService) ListThreadMedia(ctx context.Context, req *grpcCWMPb.ListThreadMediaRequest) (*grpcCWMPb.ListThreadMediaResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("ListThreadMedia - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	threadId := req.GetThreadId()
+	if len(threadId) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid threadId")
+	}
+	limit := int64(30)
+	if req.Limit != nil && req.GetLimit() > 0 {
+		limit = req.GetLimit()
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	cursorCreatedAt, cursorId, err := decodeThreadMediaCursor(req.GetCursor())
+	if err != nil {
+		log.Printf("ListThreadMedia - Invalid cursor: %v\n", err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid cursor")
+	}
+
+	signalThread, err := dao.GetSignalThreadDAO().FindByThreadId(ctx, threadId)
+	if err != nil {
+		log.Printf("ListThreadMedia - Invalid thread err: %v\n", err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid thread")
+	}
+
+	//a former participant still sees the medias sent before the removal
+	maxCreatedAt := int64(0)
+	if !slices.Contains(signalThread.Participants, grpcSession.User.PhoneFull) {
+		maxCreatedAt = signalThread.RemovedAt(grpcSession.User.PhoneFull)
+		if maxCreatedAt <= 0 {
+			return nil, status.Errorf(codes.PermissionDenied, "Not thread's member")
+		}
+	}
+
+	threadMedias, err := dao.GetThreadMediaDAO().FindPageOfThread(
+		ctx,
+		grpcSession.User.PhoneFull,
+		threadId, req.GetMediaType(),
+		maxCreatedAt, cursorCreatedAt, cursorId, limit) //Sort descending by createdAt
+	if err != nil {
+		log.Printf("ListThreadMedia - err: %v\n", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	medias := []*grpcCWMPb.ThreadMediaInfo{}
+	for _, threadMedia := range threadMedias {
+		media := &grpcCWMPb.ThreadMediaInfo{
+			MsgId:        threadMedia.MsgId,
+			ThreadId:     threadMedia.ThreadId,
+			Sender:       threadMedia.Sender,
+			MediaType:    threadMedia.MediaType,
+			Url:          threadMedia.URL,
+			UrlTitle:     threadMedia.URLTitle,
+			UrlThumbnail: threadMedia.URLThumbnail,
+			CreatedAt:    threadMedia.CreatedAt,
+		}
+		if len(threadMedia.FileId) > 0 {
+			media.FileInfo = &cwmSignalMsgPb.MultimediaFileInfo{
+				FileId:    threadMedia.FileId,
+				FileName:  threadMedia.FileName,
+				FileSize:  threadMedia.FileSize,
+				MediaType: threadMedia.FileType,
+				MimeType:  threadMedia.MimeType,
+				Checksum:  threadMedia.Checksum,
+			}
+		}
+		medias = append(medias, media)
+	}
+
+	//a full page may be followed by another one
+	nextCursor := ""
+	if int64(len(threadMedias)) == limit {
+		last := threadMedias[len(threadMedias)-1]
+		nextCursor = encodeThreadMediaCursor(last.CreatedAt, last.ID)
+	}
+
+	return &grpcCWMPb.ListThreadMediaResponse{
+		Medias:     medias,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// encodeThreadMediaCursor - the cursor is opaque to the clients: the date and the id of the last media of the page
+func encodeThreadMediaCursor(createdAt int64, id primitive.ObjectID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d_%s", createdAt, id.Hex())))
+}
+
+func decodeThreadMediaCursor(cursor string) (int64, primitive.ObjectID, error) {
+	if len(cursor) == 0 {
+		return 0, primitive.NilObjectID, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, primitive.NilObjectID, err
+	}
+	parts := strings.SplitN(string(data), "_", 2)
+	if len(parts) != 2 {
+		return 0, primitive.NilObjectID, fmt.Errorf("malformed cursor %v", cursor)
+	}
+	createdAt, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, primitive.NilObjectID, err
+	}
+	id, err := primitive.ObjectIDFromHex(parts[1])
+	if err != nil {
+		return 0, primitive.NilObjectID, err
+	}
+	return createdAt, id, nil
+}
+
+func mediaStatusErr(err error) error {
+	switch {
+	case errors.Is(err, appupload.MediaNotFoundErr),
+		errors.Is(err, appupload.VariantNotFoundErr):
+		return status.Errorf(codes.NotFound, err.Error())
+	case errors.Is(err, appupload.MediaAccessDeniedErr):
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	case errors.Is(err, appupload.MediaBlockedErr):
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, appupload.MediaScanPendingErr):
+		return status.Errorf(codes.Unavailable, err.Error())
+	default:
+		return status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+}
@@ -0,0 +1,232 @@
+package appgrpc
+
+import (
+	"context"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"sol.go/cwm/apppush"
+	"sol.go/cwm/appwebpush"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"time"
+)
+
+const (
+	minutesOfDay = 24 * 60
+)
+
+func (sv *CWMGRPCService) MuteThread(ctx context.Context, req *grpcCWMPb.MuteThreadRequest) (*grpcCWMPb.MuteThreadResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("MuteThread - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	mutedUntil := req.GetMutedUntil()
+	if mutedUntil < model.MUTED_UNTIL_UNMUTE {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid mutedUntil: %v", mutedUntil))
+	}
+
+	err := checkThreadParticipant(ctx, req.GetThreadId(), grpcSession.User.PhoneFull)
+	if err != nil {
+		return nil, err
+	}
+
+	user, setting, err := dao.GetUserDAO().UpdateThreadNotificationSetting(ctx, grpcSession.User.PhoneFull, req.GetThreadId(), func(setting *model.ThreadNotificationSetting) {
+		setting.MutedUntil = mutedUntil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	sv.syncNotificationSettings(user, grpcSession.SessionId)
+
+	return &grpcCWMPb.MuteThreadResponse{
+		ThreadSetting: setting.ToProto(),
+	}, nil
+}
+
+func (sv *CWMGRPCService) SetThreadMentionsOnly(ctx context.Context, req *grpcCWMPb.SetThreadMentionsOnlyRequest) (*grpcCWMPb.SetThreadMentionsOnlyResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("SetThreadMentionsOnly - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	err := checkThreadParticipant(ctx, req.GetThreadId(), grpcSession.User.PhoneFull)
+	if err != nil {
+		return nil, err
+	}
+
+	user, setting, err := dao.GetUserDAO().UpdateThreadNotificationSetting(ctx, grpcSession.User.PhoneFull, req.GetThreadId(), func(setting *model.ThreadNotificationSetting) {
+		setting.MentionsOnly = req.GetMentionsOnly()
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	sv.syncNotificationSettings(user, grpcSession.SessionId)
+
+	return &grpcCWMPb.SetThreadMentionsOnlyResponse{
+		ThreadSetting: setting.ToProto(),
+	}, nil
+}
+
+func (sv *CWMGRPCService) UpdateDoNotDisturb(ctx context.Context, req *grpcCWMPb.UpdateDoNotDisturbRequest) (*grpcCWMPb.UpdateDoNotDisturbResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("UpdateDoNotDisturb - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	doNotDisturb := req.GetDoNotDisturb()
+	if doNotDisturb == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid DoNotDisturb")
+	}
+
+	if doNotDisturb.GetStartMinute() < 0 || doNotDisturb.GetStartMinute() >= minutesOfDay ||
+		doNotDisturb.GetEndMinute() < 0 || doNotDisturb.GetEndMinute() >= minutesOfDay {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid DoNotDisturb minutes")
+	}
+
+	_, err := time.LoadLocation(doNotDisturb.GetTimezone())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid timezone: %v", doNotDisturb.GetTimezone()))
+	}
+
+	schedule := model.DoNotDisturbSchedule{
+		Enabled:     doNotDisturb.GetEnabled(),
+		StartMinute: doNotDisturb.GetStartMinute(),
+		EndMinute:   doNotDisturb.GetEndMinute(),
+		Timezone:    doNotDisturb.GetTimezone(),
+	}
+
+	updateFields := primitive.M{}
+	updateFields["doNotDisturb"] = schedule
+	update := primitive.M{"$set": updateFields}
+
+	user, err := dao.GetUserDAO().UpdateByPhoneFull(ctx, grpcSession.User.PhoneFull, update, []interface{}{}, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	sv.syncNotificationSettings(user, grpcSession.SessionId)
+
+	return &grpcCWMPb.UpdateDoNotDisturbResponse{
+		DoNotDisturb: user.DoNotDisturb.ToProto(),
+	}, nil
+}
+
+func (sv *CWMGRPCService) GetNotificationSettings(ctx context.Context, req *grpcCWMPb.GetNotificationSettingsRequest) (*grpcCWMPb.GetNotificationSettingsResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("GetNotificationSettings - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	settings := grpcSession.User.NotificationSettingsToProto()
+
+	return &grpcCWMPb.GetNotificationSettingsResponse{
+		ThreadSettings: settings.GetThreadSettings(),
+		DoNotDisturb:   settings.GetDoNotDisturb(),
+	}, nil
+}
+
+func (sv *CWMGRPCService) UpdateWebPushSubscription(ctx context.Context, req *grpcCWMPb.UpdateWebPushSubscriptionRequest) (*grpcCWMPb.UpdateWebPushSubscriptionResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("UpdateWebPushSubscription - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	idx := slices.IndexFunc(grpcSession.User.Sessions, func(u model.UserSession) bool { return u.SessionId == grpcSession.SessionId })
+	if idx < 0 {
+		return nil, status.Errorf(codes.Internal, fmt.Sprint("Cannot get UserSession"))
+	}
+
+	userSession := grpcSession.User.Sessions[idx]
+	if userSession.OsType != grpcCWMPb.OS_TYPE_WEBAPP {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprint("Invalid push token service"))
+	}
+
+	subscription := req.GetSubscription()
+	err := appwebpush.ValidateEndpoint(subscription.GetEndpoint())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid subscription: %v", err))
+	}
+
+	err = appwebpush.ValidateSubscriptionKeys(subscription.GetP256Dh(), subscription.GetAuth())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid subscription: %v", err))
+	}
+
+	updateFields := primitive.M{}
+	updateFields["sessions.$[updateSession].pushtokenID"] = subscription.GetEndpoint()
+	updateFields["sessions.$[updateSession].webPushP256dh"] = subscription.GetP256Dh()
+	updateFields["sessions.$[updateSession].webPushAuth"] = subscription.GetAuth()
+	updateFields["sessions.$[updateSession].pushFailureCount"] = 0
+	if req.Locale != nil {
+		updateFields["sessions.$[updateSession].locale"] = req.GetLocale()
+	}
+
+	arrayFilter := primitive.M{}
+	arrayFilter["updateSession.sessionId"] = userSession.SessionId
+	arrayFilters := []interface{}{arrayFilter}
+
+	update := primitive.M{"$set": updateFields}
+	_, err = dao.GetUserDAO().UpdateByPhoneFull(ctx, grpcSession.User.PhoneFull, update, arrayFilters, false)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	return &grpcCWMPb.UpdateWebPushSubscriptionResponse{
+		Subscription: subscription,
+	}, nil
+}
+
+func (sv *CWMGRPCService) GetWebPushConfig(ctx context.Context, req *grpcCWMPb.GetWebPushConfigRequest) (*grpcCWMPb.GetWebPushConfigResponse, error) {
+	_, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("GetWebPushConfig - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	webPush, ok := apppush.GetAppPush().GetProvider(grpcCWMPb.OS_TYPE_WEBAPP).(*appwebpush.AppWebPush)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "Web push is not enabled")
+	}
+
+	return &grpcCWMPb.GetWebPushConfigResponse{
+		VapidPublicKey: webPush.VAPID.PublicKey(),
+	}, nil
+}
+
+func (sv *CWMGRPCService) syncNotificationSettings(user *model.User, executorSessionId string) {
+	go func(user *model.User, executorSessionId string) {
+		err := sv.SendNotifyNotificationSettingsChanged(user, executorSessionId)
+		if err != nil {
+			log.Println("Cannot SendNotifyNotificationSettingsChanged", err)
+		}
+	}(user, executorSessionId)
+}
+
+// checkThreadParticipant - the notification settings can only be set on the threads the user participates in
+func checkThreadParticipant(ctx context.Context, threadId string, phoneFull string) error {
+	signalThread, err := dao.GetSignalThreadDAO().FindByThreadId(ctx, threadId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid thread: %v", threadId))
+	}
+
+	idx := slices.IndexFunc(signalThread.Participants, func(p string) bool { return p == phoneFull })
+	if idx < 0 {
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid participant: %v", threadId))
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"sol.go/cwm/model"
 	"sol.go/cwm/proto/cwmSignalMsgPb"
 	"sol.go/cwm/proto/grpcCWMPb"
+	"sol.go/cwm/sip"
 	"sol.go/cwm/utils"
 	"strings"
 	"time"
@@ -245,6 +246,8 @@ func (sv *CWMGRPCService) LeaveGroupThread(ctx context.Context, req *grpcCWMPb.L
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
 	}
 
+	go sip.ClearUnread(context.Background(), threadId, []string{grpcSession.User.PhoneFull})
+
 	go func(thread *model.SignalThread, executor *model.User, executorSessionId string) {
 		err := sv.SendGroupThreadNotificationMessage(
 			thread,
@@ -298,6 +301,8 @@ func (sv *CWMGRPCService) DeleteAndLeaveGroupThread(ctx context.Context, req *gr
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
 	}
 
+	go sip.ClearUnread(context.Background(), threadId, []string{grpcSession.User.PhoneFull})
+
 	phoneFulls := []string{grpcSession.User.PhoneFull}
 	_, err = dao.GetSignalMsgDAO().AppendDeleteUsersByThreadId(ctx, threadId, phoneFulls)
 	if err != nil {
@@ -305,6 +310,12 @@ func (sv *CWMGRPCService) DeleteAndLeaveGroupThread(ctx context.Context, req *gr
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
 	}
 
+	err = sip.DeleteThreadMediaOfThread(ctx, threadId, phoneFulls)
+	if err != nil {
+		log.Printf("DeleteAndLeaveGroupThread - err: %v\n", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
 	go func(thread *model.SignalThread, executor *model.User, executorSessionId string) {
 		err := sv.SendGroupThreadNotificationMessage(
 			thread,
@@ -437,6 +448,8 @@ func (sv *CWMGRPCService) RemoveGroupThreadParticipant(ctx context.Context, req
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
 	}
 
+	go sip.ClearUnread(context.Background(), threadId, req.GetRemoveParticipants())
+
 	go func(thread *model.SignalThread, executor *model.User, executorSessionId string, targetMembers []string) {
 		err := sv.SendGroupThreadNotificationMessage(
 			thread,
@@ -0,0 +1,254 @@
+package appgrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"log"
+	"sol.go/cwm/appupload"
+	"sol.go/cwm/proto/grpcCWMPb"
+)
+
+func (sv *CWMGRPCService) CheckMediaExists(ctx context.Context, req *grpcCWMPb.CheckMediaExistsRequest) (*grpcCWMPb.CheckMediaExistsResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("CheckMediaExists - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	mediaMsgInfo := req.GetMediaMsgInfo()
+	if mediaMsgInfo == nil || len(mediaMsgInfo.GetMsgId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid MediaMsgInfo")
+	}
+	err := appupload.ValidateChecksum(mediaMsgInfo.GetChecksum())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	s3FileInfo, err := appupload.LinkExistingFile(ctx,
+		grpcSession.User.PhoneFull,
+		mediaMsgInfo.GetMsgId(),
+		mediaMsgInfo.GetThreadId(),
+		mediaMsgInfo.GetMediaType(),
+		mediaMsgInfo.GetChecksum(),
+		req.GetFileSize())
+	if err != nil {
+		return nil, uploadStatusErr(err)
+	}
+
+	if s3FileInfo == nil {
+		return &grpcCWMPb.CheckMediaExistsResponse{
+			Exists: false,
+		}, nil
+	}
+
+	log.Printf("linked file with name: %s, size: %d, MsgId: %s, FileId: %s, checkSum: %s\n", s3FileInfo.FileName, s3FileInfo.FileSize, s3FileInfo.MsgId, s3FileInfo.FileId, s3FileInfo.Checksum)
+
+	return &grpcCWMPb.CheckMediaExistsResponse{
+		Exists:   true,
+		FileId:   s3FileInfo.FileId,
+		FileName: s3FileInfo.FileName,
+		FileSize: s3FileInfo.FileSize,
+		CheckSum: s3FileInfo.Checksum,
+		MsgId:    s3FileInfo.MsgId,
+
+		OriginalChecksum: s3FileInfo.OriginalChecksum,
+	}, nil
+}
+
+func (sv *CWMGRPCService) BeginUpload(ctx context.Context, req *grpcCWMPb.BeginUploadRequest) (*grpcCWMPb.BeginUploadResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("BeginUpload - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	mediaMsgInfo := req.GetMediaMsgInfo()
+	if mediaMsgInfo == nil || len(mediaMsgInfo.GetMsgId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid MediaMsgInfo")
+	}
+	err := appupload.ValidateChecksum(mediaMsgInfo.GetChecksum())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	uploadSession, err := appupload.GetUploadManager().Begin(ctx,
+		grpcSession.User.PhoneFull,
+		mediaMsgInfo.GetMsgId(),
+		mediaMsgInfo.GetThreadId(),
+		mediaMsgInfo.GetMediaType(),
+		mediaMsgInfo.GetChecksum(),
+		req.GetFileSize())
+	if err != nil {
+		return nil, uploadStatusErr(err)
+	}
+
+	return &grpcCWMPb.BeginUploadResponse{
+		UploadId:  uploadSession.UploadId,
+		ChunkSize: uploadSession.ChunkSize,
+		ExpiredAt: uploadSession.ExpiredAt,
+	}, nil
+}
+
+func (sv *CWMGRPCService) UploadChunk(stream grpcCWMPb.CWMService_UploadChunkServer) error {
+	grpcSession, ok := stream.Context().Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("UploadChunk - can not cast GrpcSession")
+		return GRPCInvalidSessionErr
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	chunkInfo := req.GetChunkInfo()
+	if chunkInfo == nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid UploadChunkInfo")
+	}
+
+	uploadManager := appupload.GetUploadManager()
+	chunkData := bytes.Buffer{}
+
+	for {
+		req, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Println("Cannot receive chunk data", err)
+			return err
+		}
+
+		//the chunk is one part of the upload, at most ChunkSize
+		if int64(chunkData.Len()+len(req.GetChunkData())) > uploadManager.ChunkSize {
+			return status.Errorf(codes.InvalidArgument, "chunk is too large: > %d", uploadManager.ChunkSize)
+		}
+		chunkData.Write(req.GetChunkData())
+	}
+
+	uploadSession, err := uploadManager.WriteChunk(stream.Context(), grpcSession.User.PhoneFull, chunkInfo.GetUploadId(), chunkInfo.GetOffset(), chunkData.Bytes())
+	if err != nil {
+		return uploadStatusErr(err)
+	}
+
+	err = stream.SendAndClose(&grpcCWMPb.UploadChunkResponse{
+		UploadId:  uploadSession.UploadId,
+		Offset:    uploadSession.Offset,
+		ExpiredAt: uploadSession.ExpiredAt,
+	})
+	if err != nil {
+		return status.Errorf(codes.Unknown, "cannot send response: %v", err)
+	}
+
+	return nil
+}
+
+func (sv *CWMGRPCService) QueryUploadOffset(ctx context.Context, req *grpcCWMPb.QueryUploadOffsetRequest) (*grpcCWMPb.QueryUploadOffsetResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("QueryUploadOffset - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	uploadSession, err := appupload.GetUploadManager().Find(ctx, grpcSession.User.PhoneFull, req.GetUploadId())
+	if err != nil {
+		return nil, uploadStatusErr(err)
+	}
+
+	return &grpcCWMPb.QueryUploadOffsetResponse{
+		UploadId:  uploadSession.UploadId,
+		Offset:    uploadSession.Offset,
+		FileSize:  uploadSession.FileSize,
+		ChunkSize: uploadSession.ChunkSize,
+		ExpiredAt: uploadSession.ExpiredAt,
+	}, nil
+}
+
+func (sv *CWMGRPCService) CompleteUpload(ctx context.Context, req *grpcCWMPb.CompleteUploadRequest) (*grpcCWMPb.CompleteUploadResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("CompleteUpload - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	s3FileInfo, err := appupload.GetUploadManager().Complete(ctx, grpcSession.User.PhoneFull, req.GetUploadId())
+	if err != nil {
+		return nil, uploadStatusErr(err)
+	}
+
+	log.Printf("saved file with name: %s, size: %d, MsgId: %s, FileId: %s, checkSum: %s\n", s3FileInfo.FileName, s3FileInfo.FileSize, s3FileInfo.MsgId, s3FileInfo.FileId, s3FileInfo.Checksum)
+
+	return &grpcCWMPb.CompleteUploadResponse{
+		FileId:   s3FileInfo.FileId,
+		FileName: s3FileInfo.FileName,
+		FileSize: s3FileInfo.FileSize,
+		CheckSum: s3FileInfo.Checksum,
+		MsgId:    s3FileInfo.MsgId,
+
+		OriginalChecksum: s3FileInfo.OriginalChecksum,
+	}, nil
+}
+
+func (sv *CWMGRPCService) GetStorageUsage(ctx context.Context, req *grpcCWMPb.GetStorageUsageRequest) (*grpcCWMPb.GetStorageUsageResponse, error) {
+	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("GetStorageUsage - can not cast GrpcSession")
+		return nil, status.Errorf(codes.PermissionDenied, "Invalid GrpcSession")
+	}
+
+	storageQuota := appupload.GetStorageQuota()
+	usage, err := storageQuota.Usage(ctx, grpcSession.User.PhoneFull)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	return &grpcCWMPb.GetStorageUsageResponse{
+		StoredBytes:      usage.StoredBytes,
+		FileCount:        usage.FileCount,
+		QuotaBytes:       storageQuota.QuotaBytes,
+		DailyUploads:     usage.DailyUploads,
+		DailyUploadLimit: storageQuota.DailyUploads,
+		DailyBytes:       usage.DailyBytes,
+		DailyBytesLimit:  storageQuota.DailyBytes,
+		DailyResetAt:     usage.DailyResetAt,
+	}, nil
+}
+
+func uploadStatusErr(err error) error {
+	switch {
+	case errors.Is(err, appupload.UploadNotFoundErr):
+		return status.Errorf(codes.NotFound, err.Error())
+	case errors.Is(err, appupload.UploadBusyErr),
+		errors.Is(err, appupload.BlobDeletedErr):
+		return status.Errorf(codes.Aborted, err.Error())
+	case errors.Is(err, appupload.InvalidMediaMsgErr),
+		errors.Is(err, appupload.MediaAccessDeniedErr):
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	case errors.Is(err, appupload.StorageQuotaExceededErr),
+		errors.Is(err, appupload.DailyUploadLimitErr):
+		return status.Errorf(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, appupload.UploadIncompleteErr),
+		errors.Is(err, appupload.MediaBlockedErr):
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, appupload.InvalidFileSizeErr),
+		errors.Is(err, appupload.InvalidOffsetErr),
+		errors.Is(err, appupload.InvalidChunkSizeErr),
+		errors.Is(err, appupload.ChecksumMismatchErr),
+		errors.Is(err, appupload.InvalidChecksumErr),
+		errors.Is(err, appupload.InvalidImageErr),
+		errors.Is(err, appupload.InvalidVideoErr),
+		errors.Is(err, appupload.InvalidAudioErr),
+		errors.Is(err, appupload.InvalidDocErr),
+		errors.Is(err, appupload.InvalidImageDataErr),
+		errors.Is(err, appupload.ImageTooLargeErr):
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+}
@@ -12,7 +12,9 @@ import (
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
 	"log"
+	"sol.go/cwm/appupload"
 	"sol.go/cwm/dao"
 	"sol.go/cwm/model"
 	"sol.go/cwm/proto/grpcCWMPb"
@@ -145,6 +147,7 @@ func (sv *CWMGRPCService) VerifyAuthencode(ctx context.Context, req *grpcCWMPb.V
 		Manufacturer:         req.GetDeviceInfo().GetManufacturer(),
 		OsType:               req.GetDeviceInfo().GetOs(),
 		OsVersion:            req.GetDeviceInfo().GetOsVersion(),
+		Locale:               req.GetDeviceInfo().GetLocale(),
 		PushtokenID:          "",
 		SecondaryPushtokenID: "",
 		BundleId:             "",
@@ -386,6 +389,115 @@ func (sv *CWMGRPCService) UpdateProfile(ctx context.Context, req *grpcCWMPb.Upda
 	}, nil
 }
 
+func (sv *CWMGRPCService) UploadAvatar(stream grpcCWMPb.CWMService_UploadAvatarServer) error {
+	grpcSession, ok := stream.Context().Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
+	if !ok {
+		log.Println("UploadAvatar - can not cast GrpcSession")
+		return GRPCInvalidSessionErr
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	checksum := req.GetChecksum()
+	err = appupload.ValidateChecksum(checksum)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	storageQuota := appupload.GetStorageQuota()
+	allowance, err := storageQuota.ReserveUpload(stream.Context(), grpcSession.User.PhoneFull, 0)
+	if err != nil {
+		return uploadStatusErr(err)
+	}
+
+	upload := appupload.NewAvatarUpload(stream.Context(), checksum)
+	upload.Restrict(allowance)
+	for {
+		req, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Println("Cannot receive chunk data", err)
+			upload.Abort(err)
+			return err
+		}
+
+		err = upload.Write(req.GetChunkData())
+		if err != nil {
+			upload.Abort(err)
+			return uploadStatusErr(err)
+		}
+	}
+
+	err = upload.Close()
+	storageQuota.AddDailyBytes(stream.Context(), grpcSession.User.PhoneFull, upload.OriginalFileSize)
+	if err != nil {
+		return uploadStatusErr(err)
+	}
+
+	s3FileInfo := &model.S3FileInfo{
+		FileId:    utils.GenerateUUID(),
+		MsgId:     static.AvatarMsgPrefix + grpcSession.User.PhoneFull,
+		Uploader:  grpcSession.User.PhoneFull,
+		FileName:  upload.FileName,
+		FileSize:  upload.FileSize,
+		Checksum:  upload.Checksum,
+		MediaType: upload.MediaType,
+		CreatedAt: time.Now().UnixMilli(),
+
+		OriginalChecksum: upload.OriginalChecksum,
+		OriginalFileSize: upload.OriginalFileSize,
+	}
+	s3FileInfo, err = appupload.SaveFileInfo(stream.Context(), s3FileInfo, upload.MimeType)
+	if err != nil {
+		return uploadStatusErr(err)
+	}
+
+	user, err := dao.GetUserDAO().FindByPhoneFull(stream.Context(), grpcSession.User.PhoneFull)
+	if err != nil {
+		return status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+	oldAvatar := user.Avatar
+
+	update := primitive.M{"$set": primitive.M{"avatar": s3FileInfo.FileId}}
+	user, err = dao.GetUserDAO().UpdateByPhoneFull(stream.Context(), grpcSession.User.PhoneFull, update, []interface{}{}, false)
+	if err != nil {
+		return status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+	}
+
+	//the blob of the previous avatar is deleted unless it is shared
+	if len(oldAvatar) > 0 {
+		go func(fileId string) {
+			_, err := appupload.ReleaseFile(context.Background(), fileId)
+			if err != nil {
+				log.Println("Cannot release previous avatar", fileId, err)
+			}
+		}(oldAvatar)
+	}
+
+	go func(userOTT model.User) {
+		err := sv.SendNotifyUpdateContactOTT(userOTT)
+		if err != nil {
+			log.Println("Cannot SendNotifyUpdateContactOTT", err)
+		}
+	}(*user)
+
+	err = stream.SendAndClose(&grpcCWMPb.UploadAvatarResponse{
+		Avatar: s3FileInfo.FileId,
+		MsgId:  s3FileInfo.MsgId,
+	})
+	if err != nil {
+		return status.Errorf(codes.Unknown, "cannot send response: %v", err)
+	}
+
+	return nil
+}
+
 func (sv *CWMGRPCService) UpdateUsername(ctx context.Context, req *grpcCWMPb.UpdateUsernameRequest) (*grpcCWMPb.UpdateUsernameResponse, error) {
 	grpcSession, ok := ctx.Value(GRPC_CTX_KEY_SESSION).(*GrpcSession)
 	if !ok {
@@ -467,6 +579,10 @@ func (sv *CWMGRPCService) UpdatePushToken(ctx context.Context, req *grpcCWMPb.Up
 
 	updateFields["sessions.$[updateSession].bundleId"] = pushTokenInfo.GetBundleid()
 	updateFields["sessions.$[updateSession].appId"] = pushTokenInfo.GetAppid()
+	updateFields["sessions.$[updateSession].pushFailureCount"] = 0
+	if pushTokenInfo.Locale != nil {
+		updateFields["sessions.$[updateSession].locale"] = pushTokenInfo.GetLocale()
+	}
 
 	arrayFilter := primitive.M{}
 	arrayFilter["updateSession.sessionId"] = userSession.SessionId
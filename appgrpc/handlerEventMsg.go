@@ -84,6 +84,38 @@ func (sv *CWMGRPCService) SendNotifyUpdateContactOTT(newUserOTT model.User) erro
 	return nil
 }
 
+// SendNotifyNotificationSettingsChanged syncs the user's notification settings to the other sessions of the user
+func (sv *CWMGRPCService) SendNotifyNotificationSettingsChanged(user *model.User, executorSessionID string) error {
+	log.Println("Call SendNotifyNotificationSettingsChanged")
+
+	receiverSessions := []string{}
+	for _, session := range user.Sessions {
+		if session.SessionId != executorSessionID {
+			receiverSessions = append(receiverSessions, session.SessionId)
+		}
+	}
+
+	if len(receiverSessions) == 0 {
+		return nil
+	}
+
+	cwmRequest, err := sip.CreateSignalEventMessageNotificationSettingsChanged(user.PhoneFull, receiverSessions, user)
+	if err != nil {
+		log.Println("SendNotifyNotificationSettingsChanged err", err)
+		return err
+	}
+
+	signalThread, cwmRequest, _, err := sip.ParseAndInsertSignalMsgFromSipREQ(cwmRequest, nil)
+	if err != nil {
+		log.Println("SendNotifyNotificationSettingsChanged err", err)
+		return err
+	}
+
+	appws.GetWS().SendMsg(signalThread, cwmRequest)
+
+	return nil
+}
+
 func (sv *CWMGRPCService) SendGroupThreadNotificationMessage(signalThread *model.SignalThread,
 	notification_type cwmSignalMsgPb.SIGNAL_GROUP_THREAD_NOTIFICATION_MSG_TYPE,
 	executor *model.User, executorSessionID string,
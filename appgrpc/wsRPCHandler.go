@@ -0,0 +1,167 @@
+package appgrpc
+
+import (
+	"context"
+	"fmt"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"sol.go/cwm/appws"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/grpcCWMPb"
+)
+
+// wsServerStream collects the msgs of a server streaming API, so they can be returned in a single WSRPCResponse
+type wsServerStream[Res proto.Message] struct {
+	grpc.ServerStream
+	ctx      context.Context
+	payloads [][]byte
+}
+
+func (s *wsServerStream[Res]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *wsServerStream[Res]) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "Invalid stream msg")
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	s.payloads = append(s.payloads, payload)
+	return nil
+}
+
+func (s *wsServerStream[Res]) Send(res Res) error {
+	return s.SendMsg(res)
+}
+
+// RegisterWSRPC exposes the msg, thread & notification settings APIs as socket.io events, so a client can run on a single socket.io connection
+func RegisterWSRPC(ws *appws.WS) {
+	sv := &CWMGRPCService{}
+
+	//Thread APIs
+	ws.RegisterRPC("createGroupThread", wsUnaryRPC(sv.CreateGroupThread))
+	ws.RegisterRPC("checkGroupThreadInfo", wsUnaryRPC(sv.CheckGroupThreadInfo))
+	ws.RegisterRPC("changeGroupThreadName", wsUnaryRPC(sv.ChangeGroupThreadName))
+	ws.RegisterRPC("addGroupThreadParticipant", wsUnaryRPC(sv.AddGroupThreadParticipant))
+	ws.RegisterRPC("removeGroupThreadParticipant", wsUnaryRPC(sv.RemoveGroupThreadParticipant))
+	ws.RegisterRPC("promoteGroupThreadAdmin", wsUnaryRPC(sv.PromoteGroupThreadAdmin))
+	ws.RegisterRPC("revokeGroupThreadAdmin", wsUnaryRPC(sv.RevokeGroupThreadAdmin))
+	ws.RegisterRPC("leaveGroupThread", wsUnaryRPC(sv.LeaveGroupThread))
+	ws.RegisterRPC("deleteAndLeaveGroupThread", wsUnaryRPC(sv.DeleteAndLeaveGroupThread))
+
+	//Notification settings APIs
+	ws.RegisterRPC("muteThread", wsUnaryRPC(sv.MuteThread))
+	ws.RegisterRPC("setThreadMentionsOnly", wsUnaryRPC(sv.SetThreadMentionsOnly))
+	ws.RegisterRPC("updateDoNotDisturb", wsUnaryRPC(sv.UpdateDoNotDisturb))
+	ws.RegisterRPC("getNotificationSettings", wsUnaryRPC(sv.GetNotificationSettings))
+	ws.RegisterRPC("updateWebPushSubscription", wsUnaryRPC(sv.UpdateWebPushSubscription))
+	ws.RegisterRPC("getWebPushConfig", wsUnaryRPC(sv.GetWebPushConfig))
+
+	//Msg APIs
+	ws.RegisterRPC("sendMsg", wsUnaryRPC(sv.SendMsg))
+	ws.RegisterRPC("confirmReceivedMsgs", wsUnaryRPC(sv.ConfirmReceivedMsgs))
+	ws.RegisterRPC("deleteMsgsOfThread", wsUnaryRPC(sv.DeleteMsgsOfThread))
+	ws.RegisterRPC("clearAllMsgOfThread", wsUnaryRPC(sv.ClearAllMsgOfThread))
+	ws.RegisterRPC("deleteSoloThread", wsUnaryRPC(sv.DeleteSoloThread))
+	ws.RegisterRPC("getUnreadCount", wsUnaryRPC(sv.GetUnreadCount))
+	ws.RegisterRPC("checkMediaExists", wsUnaryRPC(sv.CheckMediaExists))
+	ws.RegisterRPC("beginUpload", wsUnaryRPC(sv.BeginUpload))
+	ws.RegisterRPC("queryUploadOffset", wsUnaryRPC(sv.QueryUploadOffset))
+	ws.RegisterRPC("completeUpload", wsUnaryRPC(sv.CompleteUpload))
+	ws.RegisterRPC("getMediaURL", wsUnaryRPC(sv.GetMediaURL))
+	ws.RegisterRPC("getStorageUsage", wsUnaryRPC(sv.GetStorageUsage))
+	ws.RegisterRPC("listThreadMedia", wsUnaryRPC(sv.ListThreadMedia))
+
+	ws.RegisterRPC("initialSyncMsg", func(ctx context.Context, wsCreds appws.WsCreds, payload []byte) ([][]byte, error) {
+		req := &grpcCWMPb.InitialSyncMsgRequest{}
+		stream, err := newWSServerStream[*grpcCWMPb.InitialSyncMsgResponse](ctx, wsCreds, payload, req)
+		if err != nil {
+			return nil, err
+		}
+		err = sv.InitialSyncMsg(req, stream)
+		return stream.payloads, err
+	})
+
+	ws.RegisterRPC("fetchAllUnreceivedMsg", func(ctx context.Context, wsCreds appws.WsCreds, payload []byte) ([][]byte, error) {
+		req := &grpcCWMPb.FetchAllUnreceivedMsgRequest{}
+		stream, err := newWSServerStream[*grpcCWMPb.FetchAllUnreceivedMsgResponse](ctx, wsCreds, payload, req)
+		if err != nil {
+			return nil, err
+		}
+		err = sv.FetchAllUnreceivedMsg(req, stream)
+		return stream.payloads, err
+	})
+
+	ws.RegisterRPC("fetchOldMsgOfThread", func(ctx context.Context, wsCreds appws.WsCreds, payload []byte) ([][]byte, error) {
+		req := &grpcCWMPb.FetchOldMsgOfThreadRequest{}
+		stream, err := newWSServerStream[*grpcCWMPb.FetchOldMsgOfThreadResponse](ctx, wsCreds, payload, req)
+		if err != nil {
+			return nil, err
+		}
+		err = sv.FetchOldMsgOfThread(req, stream)
+		return stream.payloads, err
+	})
+}
+
+func wsUnaryRPC[Req any, Res proto.Message](call func(context.Context, *Req) (Res, error)) appws.WSRPCHandler {
+	return func(ctx context.Context, wsCreds appws.WsCreds, payload []byte) ([][]byte, error) {
+		req := new(Req)
+		sessionCtx, err := newWSSessionContext(ctx, wsCreds, payload, any(req).(proto.Message))
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := call(sessionCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resPayload, err := proto.Marshal(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal err: %v", err))
+		}
+		return [][]byte{resPayload}, nil
+	}
+}
+
+func newWSServerStream[Res proto.Message](ctx context.Context, wsCreds appws.WsCreds, payload []byte, req proto.Message) (*wsServerStream[Res], error) {
+	sessionCtx, err := newWSSessionContext(ctx, wsCreds, payload, req)
+	if err != nil {
+		return nil, err
+	}
+	return &wsServerStream[Res]{ctx: sessionCtx}, nil
+}
+
+// newWSSessionContext unmarshals the request and reloads the socket's user, as WsCreds is only set when the socket connects
+func newWSSessionContext(ctx context.Context, wsCreds appws.WsCreds, payload []byte, req proto.Message) (context.Context, error) {
+	err := proto.Unmarshal(payload, req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid request: %v", err))
+	}
+
+	user, err := dao.GetUserDAO().FindByPhoneFull(ctx, wsCreds.User.PhoneFull)
+	if err != nil {
+		return nil, GRPCUnauthenticateUserdErr
+	}
+
+	idx := slices.IndexFunc(user.Sessions, func(c model.UserSession) bool { return c.SessionId == wsCreds.SessionId })
+	if idx < 0 {
+		return nil, GRPCInvalidSessionErr
+	}
+
+	grpcSession := &GrpcSession{
+		User:      user,
+		SessionId: wsCreds.SessionId,
+	}
+
+	return context.WithValue(ctx, GRPC_CTX_KEY_SESSION, grpcSession), nil
+}
@@ -0,0 +1,140 @@
+package apphttp
+
+import (
+	"crypto/subtle"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"os"
+	"sol.go/cwm/apppush"
+	"sol.go/cwm/appupload"
+	"strconv"
+)
+
+const (
+	ADMIN_TOKEN_HEADER = "X-Admin-Token"
+)
+
+type AdminHTTPController struct {
+	PushQueue *apppush.PushQueue
+	MediaGC   *appupload.MediaGC
+}
+
+// AdminMiddleware only lets in requests carrying ADMIN_TOKEN, the admin APIs are disabled when ADMIN_TOKEN is not set
+func AdminMiddleware() gin.HandlerFunc {
+	adminToken := os.Getenv("ADMIN_TOKEN")
+
+	return func(ctx *gin.Context) {
+		token := ctx.GetHeader(ADMIN_TOKEN_HEADER)
+		if len(adminToken) == 0 || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"status": "failed",
+				"error":  HTTPAccessDeniedErr.Error(),
+			})
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+func (sv *AdminHTTPController) GetPushStats(ctx *gin.Context) {
+	stats, err := sv.PushQueue.Stats(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"status": "failed",
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status": "success",
+		"stats":  stats,
+	})
+}
+
+// ListDeadPushJobs - query: offset (default 0), limit (default 50)
+func (sv *AdminHTTPController) ListDeadPushJobs(ctx *gin.Context) {
+	offset, err := strconv.ParseInt(ctx.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "50"), 10, 64)
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+
+	jobs, err := sv.PushQueue.ListDeadJobs(ctx, offset, offset+limit-1)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"status": "failed",
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status": "success",
+		"jobs":   jobs,
+	})
+}
+
+// ReplayDeadPushJobs - query: jobId, all dead jobs are replayed if jobId is empty
+func (sv *AdminHTTPController) ReplayDeadPushJobs(ctx *gin.Context) {
+	count, err := sv.PushQueue.ReplayDeadJobs(ctx, ctx.Query("jobId"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"status": "failed",
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":   "success",
+		"replayed": count,
+	})
+}
+
+func (sv *AdminHTTPController) PurgeDeadPushJobs(ctx *gin.Context) {
+	count, err := sv.PushQueue.PurgeDeadJobs(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"status": "failed",
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status": "success",
+		"purged": count,
+	})
+}
+
+// RunMediaGC runs the media gc now - query: dryRun (default MEDIA_GC_DRY_RUN)
+func (sv *AdminHTTPController) RunMediaGC(ctx *gin.Context) {
+	dryRun, err := strconv.ParseBool(ctx.Query("dryRun"))
+	if err != nil {
+		dryRun = sv.MediaGC.DryRun
+	}
+
+	report, err := sv.MediaGC.Run(ctx, dryRun)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, appupload.MediaGCBusyErr) {
+			statusCode = http.StatusConflict
+		}
+		ctx.JSON(statusCode, gin.H{
+			"status": "failed",
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"status": "success",
+		"report": report,
+	})
+}
@@ -1,67 +1,304 @@
 package apphttp
 
 import (
+	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"golang.org/x/exp/slices"
 	"io"
 	"log"
 	"net/http"
+	"sol.go/cwm/appupload"
+	"sol.go/cwm/blobstore"
 	"sol.go/cwm/dao"
-	"sol.go/cwm/s3Handler"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"sol.go/cwm/utils"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	mediaCacheMaxAge    = 24 * 60 * 60 //seconds - the content of a fileId never changes
+	mediaScanRetryAfter = 30           //seconds
+
+	HTTP_CTX_KEY_USER = "HTTP_CTX_KEY_USER"
 )
 
 type FileHTTPController struct {
 }
 
-func (sv *FileHTTPController) getFile(ctx *gin.Context) {
+// MediaMiddleware lets in the requests of a signed url (see appupload.MediaURLSigner) or carrying the jwt of a session in the Authorization header.
+// The access to the media of a signed url was checked when the url was signed, the user of a jwt is set in the context
+func MediaMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		signature := ctx.Query(appupload.MEDIA_URL_QUERY_SIG)
+		if len(signature) > 0 {
+			variant, err := appupload.ParseMediaVariant(ctx.Query(appupload.MEDIA_URL_QUERY_VARIANT))
+			if err == nil {
+				err = appupload.GetMediaURLSigner().Verify(ctx.Param("msgId"), ctx.Param("fileId"), variant, ctx.Query(appupload.MEDIA_URL_QUERY_EXPIRES), signature)
+			}
+			if err != nil {
+				log.Println("MediaMiddleware - invalid signed url", err)
+				abortWithError(ctx, http.StatusForbidden, HTTPAccessDeniedErr)
+				return
+			}
+
+			ctx.Next()
+			return
+		}
+
+		jwtToken := strings.TrimPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+		claims, err := utils.ParseJWTToken(jwtToken)
+		if err != nil {
+			log.Println("MediaMiddleware - Invalid jwtToken", err)
+			abortWithError(ctx, http.StatusUnauthorized, HTTPUnauthenticatedTokenErr)
+			return
+		}
+
+		user, err := dao.GetUserDAO().FindByPhoneFull(ctx, claims.Subject)
+		if err != nil {
+			log.Println("MediaMiddleware - not found user", claims.Subject)
+			abortWithError(ctx, http.StatusForbidden, HTTPUnauthenticateUserdErr)
+			return
+		}
+
+		idx := slices.IndexFunc(user.Sessions, func(c model.UserSession) bool { return c.SessionId == claims.ID })
+		if idx < 0 {
+			log.Println("MediaMiddleware - not found session:", claims.ID)
+			abortWithError(ctx, http.StatusForbidden, HTTPAccessDeniedErr)
+			return
+		}
+
+		ctx.Set(HTTP_CTX_KEY_USER, user)
+		ctx.Next()
+	}
+}
+
+// GetMedia downloads a media msg - query: variant (placeholder|preview, default the original file).
+// Supports a single byte range (Range, If-Range) and the revalidation with If-None-Match,
+// redirects to a presigned url of the BlobStore if MEDIA_PRESIGN_REDIRECT is set
+func (sv *FileHTTPController) GetMedia(ctx *gin.Context) {
 	msgId := ctx.Param("msgId")
 	fileId := ctx.Param("fileId")
 
-	s3FileInfo, err := dao.GetS3FileInfoDAO().FindByFileId(ctx, fileId)
-	if s3FileInfo == nil {
-		log.Println("Not found s3FileInfo", err)
+	variant, err := appupload.ParseMediaVariant(ctx.Query(appupload.MEDIA_URL_QUERY_VARIANT))
+	if err != nil {
+		abortWithError(ctx, http.StatusBadRequest, err)
+		return
+	}
 
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"status": "failed",
-			"error":  "not found",
-		})
+	s3FileInfo, fileName, err := appupload.FindMediaFile(ctx, msgId, fileId, variant)
+	if err != nil {
+		log.Println("GetMedia - cannot serve media", err)
+		if errors.Is(err, appupload.MediaNotFoundErr) || errors.Is(err, appupload.VariantNotFoundErr) {
+			abortWithError(ctx, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, appupload.MediaBlockedErr) {
+			abortWithError(ctx, http.StatusForbidden, err)
+			return
+		}
+		if errors.Is(err, appupload.MediaScanPendingErr) {
+			ctx.Header("Retry-After", strconv.Itoa(mediaScanRetryAfter))
+			abortWithError(ctx, http.StatusServiceUnavailable, err)
+			return
+		}
+		abortWithError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 
-	if s3FileInfo.MsgId != msgId {
-		log.Println("Invalid msgId")
+	if user, ok := ctx.Value(HTTP_CTX_KEY_USER).(*model.User); ok {
+		err = appupload.AuthorizeMediaAccess(ctx, s3FileInfo, user.PhoneFull)
+		if err != nil {
+			log.Println("GetMedia - access denied", user.PhoneFull, err)
+			if errors.Is(err, appupload.MediaAccessDeniedErr) {
+				abortWithError(ctx, http.StatusForbidden, HTTPAccessDeniedErr)
+				return
+			}
+			abortWithError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+	}
 
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"status": "failed",
-			"error":  "invalid info",
-		})
-		return
+	blobStore := blobstore.GetBlobStore()
+	signer := appupload.GetMediaURLSigner()
+	if signer.PresignRedirect {
+		presignedURL, err := blobStore.PresignGet(ctx, fileName, signer.TTL)
+		if err == nil {
+			ctx.Header("Cache-Control", "no-store")
+			ctx.Redirect(http.StatusFound, presignedURL)
+			return
+		}
+		if !errors.Is(err, blobstore.PresignNotSupportedErr) {
+			log.Println("GetMedia - cannot presign url", err)
+		}
 	}
 
-	s3Object, err := s3Handler.GetS3FileStore().GetObject(s3FileInfo.FileName)
+	blobInfo, err := blobStore.Stat(ctx, fileName)
 	if err != nil {
-		log.Println("Get s3 Object err", err)
+		log.Println("GetMedia - Stat blob err", err)
+		if errors.Is(err, blobstore.BlobNotFoundErr) {
+			abortWithError(ctx, http.StatusNotFound, err)
+			return
+		}
+		abortWithError(ctx, http.StatusInternalServerError, err)
+		return
+	}
 
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"status": "failed",
-			"error":  "not found object",
-		})
+	etag := mediaETag(s3FileInfo, variant, blobInfo)
+	ctx.Header("ETag", etag)
+	ctx.Header("Accept-Ranges", "bytes")
+	ctx.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", mediaCacheMaxAge))
+	if !blobInfo.LastModified.IsZero() {
+		ctx.Header("Last-Modified", blobInfo.LastModified.UTC().Format(http.TimeFormat))
+	}
 
+	if etagMatch(ctx.GetHeader("If-None-Match"), etag) {
+		ctx.Status(http.StatusNotModified)
 		return
 	}
 
-	if s3Object.Body != nil {
-		defer s3Object.Body.Close()
+	statusCode := http.StatusOK
+	offset, length := int64(0), blobInfo.Size
+	rangeHeader := ctx.GetHeader("Range")
+	if len(rangeHeader) > 0 && ifRangeMatch(ctx.GetHeader("If-Range"), etag, blobInfo.LastModified) {
+		rangeOffset, rangeLength, ok, err := parseRange(rangeHeader, blobInfo.Size)
+		if err != nil {
+			ctx.Header("Content-Range", fmt.Sprintf("bytes */%d", blobInfo.Size))
+			abortWithError(ctx, http.StatusRequestedRangeNotSatisfiable, err)
+			return
+		}
+		if ok {
+			statusCode = http.StatusPartialContent
+			offset, length = rangeOffset, rangeLength
+			ctx.Header("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, blobInfo.Size))
+		}
+	}
+
+	ctx.Header("Content-Type", blobInfo.ContentType)
+	ctx.Header("Content-Length", strconv.FormatInt(length, 10))
+
+	if ctx.Request.Method == http.MethodHead {
+		ctx.Status(statusCode)
+		return
 	}
-	// If there is no content length, it is a directory
-	if s3Object.ContentLength == nil {
+
+	body, _, err := blobStore.GetRange(ctx, fileName, offset, length)
+	if err != nil {
+		log.Println("GetMedia - Get blob err", err)
+		ctx.Header("Content-Length", "")
+		if errors.Is(err, blobstore.BlobNotFoundErr) {
+			abortWithError(ctx, http.StatusNotFound, err)
+			return
+		}
+		abortWithError(ctx, http.StatusInternalServerError, err)
 		return
 	}
+	defer body.Close()
 
-	//log.Println("Content-Type", *s3Object.ContentType)
+	ctx.Status(statusCode)
+	_, err = io.Copy(ctx.Writer, body)
+	if err != nil {
+		log.Println("GetMedia - cannot send blob", err)
+	}
+}
+
+// mediaETag - the original file is identified by its md5, the variants by the ETag of the BlobStore
+func mediaETag(s3FileInfo *model.S3FileInfo, variant grpcCWMPb.MEDIA_VARIANT, blobInfo *blobstore.BlobInfo) string {
+	etag := strings.Trim(blobInfo.ETag, `"`)
+	if variant == grpcCWMPb.MEDIA_VARIANT_ORIGINAL && len(s3FileInfo.Checksum) > 0 {
+		etag = s3FileInfo.Checksum
+	}
+	return fmt.Sprintf(`"%s"`, etag)
+}
+
+// etagMatch - weak comparison of If-None-Match, a list of ETags or *
+func etagMatch(header string, etag string) bool {
+	if len(header) == 0 {
+		return false
+	}
+
+	for _, value := range strings.Split(header, ",") {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+		if value == "*" || value == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifRangeMatch - the range is only sent if the file did not change since the client got the first bytes
+func ifRangeMatch(header string, etag string, lastModified time.Time) bool {
+	if len(header) == 0 {
+		return true
+	}
+
+	if strings.HasPrefix(header, `"`) {
+		return header == etag
+	}
+
+	date, err := http.ParseTime(header)
+	if err != nil || lastModified.IsZero() {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(date)
+}
+
+// parseRange reads a single byte range (bytes=start-end, bytes=start-, bytes=-suffix).
+// ok is false if the header is ignored (malformed, several ranges) and the whole file is sent,
+// err is set if the range is not satisfiable
+func parseRange(header string, size int64) (int64, int64, bool, error) {
+	if !strings.HasPrefix(header, "bytes=") || strings.Contains(header, ",") {
+		return 0, 0, false, nil
+	}
+	spec := strings.TrimPrefix(header, "bytes=")
+
+	startValue, endValue, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return 0, 0, false, nil
+	}
+
+	if len(startValue) == 0 {
+		suffix, err := strconv.ParseInt(endValue, 10, 64)
+		if err != nil || suffix < 0 {
+			return 0, 0, false, nil
+		}
+		if suffix == 0 || size == 0 {
+			return 0, 0, false, blobstore.InvalidRangeErr
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return size - suffix, suffix, true, nil
+	}
+
+	start, err := strconv.ParseInt(startValue, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false, nil
+	}
+
+	end := size - 1
+	if len(endValue) > 0 {
+		end, err = strconv.ParseInt(endValue, 10, 64)
+		if err != nil || end < start {
+			return 0, 0, false, nil
+		}
+		if end > size-1 {
+			end = size - 1
+		}
+	}
+
+	if start >= size {
+		return 0, 0, false, blobstore.InvalidRangeErr
+	}
+	return start, end - start + 1, true, nil
+}
 
-	ctx.Header("Content-Type", *s3Object.ContentType)
-	ctx.Header("Content-Length", fmt.Sprintf("%d", *s3Object.ContentLength))
-	ctx.Status(http.StatusOK)
-	io.Copy(ctx.Writer, s3Object.Body)
+func abortWithError(ctx *gin.Context, statusCode int, err error) {
+	ctx.AbortWithStatusJSON(statusCode, gin.H{
+		"status": "failed",
+		"error":  err.Error(),
+	})
 }
@@ -7,8 +7,12 @@ import (
 	"github.com/gin-gonic/gin"
 	socketio "github.com/googollee/go-socket.io"
 	"golang.org/x/exp/slices"
+	"io"
 	"log"
+	"net"
 	"net/http"
+	"sol.go/cwm/apppush"
+	"sol.go/cwm/appupload"
 	"sol.go/cwm/appws"
 	"sol.go/cwm/dao"
 	"sol.go/cwm/model"
@@ -27,7 +31,8 @@ func GinMiddleware(allowOrigin string) gin.HandlerFunc {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", allowOrigin)
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, Content-Length, X-CSRF-Token, Token, session, Origin, Host, Connection, Accept-Encoding, Accept-Language, X-Requested-With")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, Content-Length, X-CSRF-Token, Token, session, Origin, Host, Connection, Accept-Encoding, Accept-Language, X-Requested-With, X-Admin-Token, Range, If-None-Match, If-Range")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "Content-Length, Content-Range, Accept-Ranges, ETag")
 
 		if c.Request.Method == http.MethodOptions {
 			c.AbortWithStatus(http.StatusNoContent)
@@ -85,9 +90,9 @@ func WSMiddleware() gin.HandlerFunc {
 	}
 }
 
-func StartServer(httpPort int, ws *socketio.Server) (*gin.Engine, error) {
+func StartServer(httpPort int, ws *socketio.Server) (net.Listener, *http.Server, error) {
 	//testHTTPController := TestHTTPController{}
-	//fileHTTPController := FileHTTPController{}
+	fileHTTPController := FileHTTPController{}
 
 	gin.SetMode(gin.ReleaseMode)
 
@@ -106,15 +111,31 @@ func StartServer(httpPort int, ws *socketio.Server) (*gin.Engine, error) {
 		wsRouter.POST("/*any", gin.WrapH(ws))
 	}
 
+	adminHTTPController := AdminHTTPController{
+		PushQueue: apppush.GetAppPush().Queue(),
+		MediaGC:   appupload.GetMediaGC(),
+	}
+	adminRouter := router.Group("admin")
+	adminRouter.Use(AdminMiddleware())
+	{
+		adminRouter.GET("/push/stats", adminHTTPController.GetPushStats)
+		adminRouter.GET("/push/dlq", adminHTTPController.ListDeadPushJobs)
+		adminRouter.POST("/push/dlq/replay", adminHTTPController.ReplayDeadPushJobs)
+		adminRouter.DELETE("/push/dlq", adminHTTPController.PurgeDeadPushJobs)
+		adminRouter.POST("/media/gc", adminHTTPController.RunMediaGC)
+	}
+
 	router.GET("/healthCheck", func(ctx *gin.Context) {
 		ctx.JSON(http.StatusOK, gin.H{"status": "success"})
 	})
 
 	//download media msg
-	//fileRouter := router.Group("/media")
-	//{
-	//	fileRouter.GET("/get/:msgId/:fileId", fileHTTPController.getFile)
-	//}
+	fileRouter := router.Group(appupload.MEDIA_URL_PATH)
+	fileRouter.Use(MediaMiddleware())
+	{
+		fileRouter.GET("/:msgId/:fileId", fileHTTPController.GetMedia)
+		fileRouter.HEAD("/:msgId/:fileId", fileHTTPController.GetMedia)
+	}
 
 	//testRouter := router.Group("/test")
 	//{
@@ -122,21 +143,30 @@ func StartServer(httpPort int, ws *socketio.Server) (*gin.Engine, error) {
 	//	testRouter.GET("/test", testHTTPController.Test)
 	//}
 
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(httpPort))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to listen http server: %w", err)
+	}
+
+	httpServer := &http.Server{
+		Handler: router,
+	}
+
 	go func() {
-		go func() {
-			err := ws.Serve()
-			if err != nil {
-				log.Fatalf("Failed to serve ws: %v", err)
-			}
-		}()
-		defer ws.Close()
+		err := ws.Serve()
+		if err != nil && err != io.EOF { //io.EOF when ws server is closed
+			log.Fatalf("Failed to serve ws: %v", err)
+		}
+	}()
 
+	go func() {
 		fmt.Printf("Starting http server on: %v\n", httpPort)
-		err := router.Run(":" + strconv.Itoa(httpPort))
-		if err != nil {
+		err := httpServer.Serve(listener)
+		//net.ErrClosed when the listener is closed before the shutdown of the server
+		if err != nil && err != http.ErrServerClosed && !errors.Is(err, net.ErrClosed) {
 			log.Fatalf("Failed to serve http: %v", err)
 		}
 	}()
 
-	return router, nil
+	return listener, httpServer, nil
 }
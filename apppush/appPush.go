@@ -0,0 +1,219 @@
+package apppush
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"sync"
+	"time"
+)
+
+// AppPush routes PushMsgs to the PushProvider of each target's OsType, through the redis push job queue
+type AppPush struct {
+	providerLock sync.RWMutex
+	providers    map[grpcCWMPb.OS_TYPE]PushProvider
+
+	recorder PushRecorder
+	queue    *PushQueue
+}
+
+// PushRecorder records the payload paths and the failed results of the sent pushes
+type PushRecorder interface {
+	RecordPayloadPath(providerName string, payloadPath string, count int)
+	RecordFailedResults(results []PushResult)
+}
+
+// storePushRecorder counts the payload paths in redis, and records the failures on the users' sessions
+type storePushRecorder struct{}
+
+var (
+	singletonAppPush *AppPush
+	onceAppPush      sync.Once
+)
+
+func GetAppPush() *AppPush {
+	onceAppPush.Do(func() {
+		fmt.Println("Init App Push...")
+		singletonAppPush = NewAppPush()
+	})
+	return singletonAppPush
+}
+
+func NewAppPush() *AppPush {
+	appPush := &AppPush{
+		providers: map[grpcCWMPb.OS_TYPE]PushProvider{},
+		recorder:  storePushRecorder{},
+	}
+	appPush.queue = newPushQueue(appPush)
+	return appPush
+}
+
+func (appPush *AppPush) SetProvider(osType grpcCWMPb.OS_TYPE, provider PushProvider) {
+	appPush.providerLock.Lock()
+	defer appPush.providerLock.Unlock()
+
+	log.Printf("Push provider of %v: %v\n", osType, provider.Name())
+	appPush.providers[osType] = provider
+}
+
+func (appPush *AppPush) GetProvider(osType grpcCWMPb.OS_TYPE) PushProvider {
+	appPush.providerLock.RLock()
+	defer appPush.providerLock.RUnlock()
+
+	return appPush.providers[osType]
+}
+
+// SetRecorder replaces the recorder of the sent pushes, a FakePushRecorder in tests
+func (appPush *AppPush) SetRecorder(recorder PushRecorder) {
+	appPush.recorder = recorder
+}
+
+func (appPush *AppPush) Queue() *PushQueue {
+	return appPush.queue
+}
+
+func (appPush *AppPush) Start() {
+	appPush.queue.Start()
+}
+
+// Stop stops the queue workers after their current jobs, queued jobs are kept in redis for the next start
+func (appPush *AppPush) Stop(ctx context.Context) error {
+	return appPush.queue.Stop(ctx)
+}
+
+// RequestSendMsg enqueues a push job per OsType of the targets
+func (appPush *AppPush) RequestSendMsg(pushMsg *PushMsg) {
+	for _, osPushMsg := range splitByOsType(pushMsg) {
+		err := appPush.queue.Enqueue(context.Background(), osPushMsg)
+		if err != nil {
+			log.Println("Push - enqueue job failed, send directly", err)
+			go appPush.HandleSendMsg(osPushMsg)
+		}
+	}
+}
+
+// HandleSendMsg sends the msg right away (without retrying), splits the targets by OsType and sends them with the matching provider
+func (appPush *AppPush) HandleSendMsg(pushMsg *PushMsg) []PushResult {
+	results := []PushResult{}
+	for _, osPushMsg := range splitByOsType(pushMsg) {
+		results = append(results, appPush.send(osPushMsg)...)
+	}
+
+	appPush.recorder.RecordFailedResults(results)
+
+	return results
+}
+
+// send sends a msg whose targets have the same OsType, the notification is rendered per locale of the targets
+func (appPush *AppPush) send(pushMsg *PushMsg) []PushResult {
+	osType := pushMsg.Targets[0].OsType
+	provider := appPush.GetProvider(osType)
+	if provider == nil {
+		log.Printf("Push - no provider of %v, drop %v targets\n", osType, len(pushMsg.Targets))
+		return []PushResult{}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	results := []PushResult{}
+	for _, localePushMsg := range splitByLocale(pushMsg) {
+		if localePushMsg.Notification != nil {
+			localePushMsg.Title, localePushMsg.Body = localePushMsg.Notification.Render(localePushMsg.Targets[0].Locale)
+		}
+
+		payloadPath := applyPayloadStrategy(localePushMsg)
+		if payloadPath == PUSH_PAYLOAD_PATH_FETCH {
+			log.Printf("Push - msg %v of thread %v is too big, send fetch ping to %v targets\n", localePushMsg.MsgId, localePushMsg.ThreadId, len(localePushMsg.Targets))
+		}
+		appPush.recorder.RecordPayloadPath(provider.Name(), payloadPath, len(localePushMsg.Targets))
+
+		results = append(results, provider.Send(ctx, localePushMsg)...)
+	}
+
+	failedCount := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failedCount++
+		}
+	}
+	log.Printf("Push %v - success %v - failed %v - of all %v - priorityLevel: %v", provider.Name(), len(results)-failedCount, failedCount, len(pushMsg.Targets), pushMsg.PriorityLevel)
+
+	return results
+}
+
+// splitByLocale - a data only msg is not split
+func splitByLocale(pushMsg *PushMsg) []*PushMsg {
+	if pushMsg.Notification == nil {
+		return []*PushMsg{pushMsg}
+	}
+
+	targetsByLocale := map[string][]PushTarget{}
+	locales := []string{}
+	for _, target := range pushMsg.Targets {
+		locale := normalizeLocale(target.Locale)
+		if _, existed := targetsByLocale[locale]; !existed {
+			locales = append(locales, locale)
+		}
+		targetsByLocale[locale] = append(targetsByLocale[locale], target)
+	}
+
+	pushMsgs := []*PushMsg{}
+	for _, locale := range locales {
+		localePushMsg := *pushMsg
+		localePushMsg.Targets = targetsByLocale[locale]
+		pushMsgs = append(pushMsgs, &localePushMsg)
+	}
+	return pushMsgs
+}
+
+type pushRoute struct {
+	osType grpcCWMPb.OS_TYPE
+	voip   bool
+}
+
+// splitByOsType - the VoIP targets of iOS are split from the remote ones, they are sent as VoIP pushes
+func splitByOsType(pushMsg *PushMsg) []*PushMsg {
+	targetsByRoute := map[pushRoute][]PushTarget{}
+	routes := []pushRoute{}
+	for _, target := range pushMsg.Targets {
+		route := pushRoute{osType: target.OsType, voip: target.VoIP}
+		if _, existed := targetsByRoute[route]; !existed {
+			routes = append(routes, route)
+		}
+		targetsByRoute[route] = append(targetsByRoute[route], target)
+	}
+
+	pushMsgs := []*PushMsg{}
+	for _, route := range routes {
+		osPushMsg := *pushMsg
+		osPushMsg.Targets = targetsByRoute[route]
+		osPushMsg.VoIP = route.voip
+		pushMsgs = append(pushMsgs, &osPushMsg)
+	}
+	return pushMsgs
+}
+
+// RecordFailedResults records the push failures per session, and prunes the tokens reported as invalid.
+// Retryable failures are not the session's fault, they are not recorded
+func (storePushRecorder) RecordFailedResults(results []PushResult) {
+	for _, result := range results {
+		if result.Err == nil || IsRetryable(result.Err) {
+			continue
+		}
+
+		invalidToken := ""
+		if errors.Is(result.Err, InvalidTokenErr) {
+			invalidToken = result.Target.Token
+			log.Printf("Push - prune invalid token of %v - session %v\n", result.Target.PhoneFull, result.Target.SessionId)
+		}
+
+		_, err := dao.GetUserDAO().RecordSessionPushFailure(context.Background(), result.Target.PhoneFull, result.Target.SessionId, invalidToken)
+		if err != nil {
+			log.Println("Push - RecordSessionPushFailure error", err)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package apppush
+
+import (
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"strings"
+	"testing"
+)
+
+type testProviders struct {
+	fcm      *FakePushProvider
+	apns     *FakePushProvider
+	webPush  *FakePushProvider
+	recorder *FakePushRecorder
+}
+
+func newTestAppPush() (*AppPush, *testProviders) {
+	providers := &testProviders{
+		fcm:      NewFakePushProvider(PUSH_PROVIDER_FCM),
+		apns:     NewFakePushProvider(PUSH_PROVIDER_APNS),
+		webPush:  NewFakePushProvider(PUSH_PROVIDER_WEBPUSH),
+		recorder: NewFakePushRecorder(),
+	}
+
+	appPush := NewAppPush()
+	appPush.SetProvider(grpcCWMPb.OS_TYPE_ANDROID, providers.fcm)
+	appPush.SetProvider(grpcCWMPb.OS_TYPE_IOS, providers.apns)
+	appPush.SetProvider(grpcCWMPb.OS_TYPE_WEBAPP, providers.webPush)
+	appPush.SetRecorder(providers.recorder)
+	return appPush, providers
+}
+
+func TestHandleSendMsgRoutesByOsType(t *testing.T) {
+	appPush, providers := newTestAppPush()
+
+	pushMsg := &PushMsg{
+		Targets: []PushTarget{
+			{SessionId: "android", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "fcm-token"},
+			{SessionId: "ios", OsType: grpcCWMPb.OS_TYPE_IOS, Token: "remote-token"},
+			{SessionId: "ios-voip", OsType: grpcCWMPb.OS_TYPE_IOS, Token: "voip-token", VoIP: true},
+			{SessionId: "web", OsType: grpcCWMPb.OS_TYPE_WEBAPP, Token: "https://push.example/endpoint"},
+		},
+		DataBase64: "bXNn",
+	}
+
+	results := appPush.HandleSendMsg(pushMsg)
+	if len(results) != len(pushMsg.Targets) {
+		t.Fatalf("results = %v, want %v", len(results), len(pushMsg.Targets))
+	}
+
+	if sent := providers.fcm.Sent(); len(sent) != 1 || sent[0].Targets[0].SessionId != "android" {
+		t.Errorf("fcm sent = %+v", sent)
+	}
+	if sent := providers.webPush.Sent(); len(sent) != 1 || sent[0].Targets[0].SessionId != "web" {
+		t.Errorf("webpush sent = %+v", sent)
+	}
+
+	sent := providers.apns.Sent()
+	if len(sent) != 2 {
+		t.Fatalf("apns sent = %v msgs, want the remote and the VoIP ones", len(sent))
+	}
+	for _, pushMsg := range sent {
+		if len(pushMsg.Targets) != 1 || pushMsg.VoIP != pushMsg.Targets[0].VoIP {
+			t.Errorf("apns msg VoIP = %v, targets = %+v", pushMsg.VoIP, pushMsg.Targets)
+		}
+	}
+}
+
+func TestHandleSendMsgWithoutProvider(t *testing.T) {
+	appPush := NewAppPush()
+	appPush.SetRecorder(NewFakePushRecorder())
+
+	results := appPush.HandleSendMsg(&PushMsg{
+		Targets: []PushTarget{{SessionId: "android", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "fcm-token"}},
+	})
+	if len(results) != 0 {
+		t.Errorf("results = %+v, the targets of a missing provider are dropped", results)
+	}
+}
+
+func TestHandleSendMsgRendersPerLocale(t *testing.T) {
+	appPush, providers := newTestAppPush()
+
+	pushMsg := &PushMsg{
+		Targets: []PushTarget{
+			{SessionId: "en", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "en-token", Locale: "en"},
+			{SessionId: "vi", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "vi-token", Locale: "vi"},
+		},
+		DataBase64: "bXNn",
+		Notification: &PushNotification{
+			ImType:     cwmSignalMsgPb.SIGNAL_IM_TYPE_MULTIMEDIA,
+			ThreadType: cwmSignalMsgPb.SIGNAL_THREAD_TYPE_SOLO,
+			SenderName: "Alice",
+			MediaType:  cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_IMAGE,
+			MediaCount: 1,
+		},
+	}
+
+	appPush.HandleSendMsg(pushMsg)
+
+	sent := providers.fcm.Sent()
+	if len(sent) != 2 {
+		t.Fatalf("fcm sent = %v msgs, want one per locale", len(sent))
+	}
+	if sent[0].Title != "Alice" || len(sent[0].Body) == 0 {
+		t.Errorf("en notification = %q - %q", sent[0].Title, sent[0].Body)
+	}
+	if sent[0].Body == sent[1].Body {
+		t.Errorf("the notification is not localized: %q", sent[0].Body)
+	}
+}
+
+func TestHandleSendMsgSendsFetchPingWhenTooBig(t *testing.T) {
+	appPush, providers := newTestAppPush()
+
+	pushMsg := &PushMsg{
+		Targets:    []PushTarget{{SessionId: "android", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "fcm-token"}},
+		DataBase64: strings.Repeat("A", maxPayloadSize),
+		ThreadId:   "thread-1",
+		MsgId:      "msg-1",
+	}
+
+	appPush.HandleSendMsg(pushMsg)
+
+	sent := providers.fcm.Sent()
+	if len(sent) != 1 || !sent[0].Fetch {
+		t.Fatalf("fcm sent = %+v, want a fetch ping", sent)
+	}
+	data := sent[0].Data()
+	if data[DataFetchKey] != "1" || data[DataThreadIdKey] != "thread-1" || data[DataMsgIdKey] != "msg-1" || len(data[DataMsgKey]) > 0 {
+		t.Errorf("fetch ping data = %v", data)
+	}
+	if paths := providers.recorder.PayloadPaths(); paths[PUSH_PROVIDER_FCM+":"+PUSH_PAYLOAD_PATH_FETCH] != 1 {
+		t.Errorf("payload paths = %v", paths)
+	}
+}
+
+func TestHandleSendMsgRecordsFailures(t *testing.T) {
+	appPush, providers := newTestAppPush()
+	providers.fcm.FailTokens["invalid-token"] = InvalidTokenErr
+	providers.fcm.FailTokens["retry-token"] = RetryableErr
+
+	appPush.HandleSendMsg(&PushMsg{
+		Targets: []PushTarget{
+			{SessionId: "invalid", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "invalid-token"},
+			{SessionId: "retry", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "retry-token"},
+			{SessionId: "valid", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "valid-token"},
+		},
+		DataBase64: "bXNn",
+	})
+
+	failed := providers.recorder.Failed()
+	if len(failed) != 1 || failed[0].Target.SessionId != "invalid" {
+		t.Errorf("failed = %+v, only the non retryable failure is recorded", failed)
+	}
+}
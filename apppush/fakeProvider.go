@@ -0,0 +1,128 @@
+package apppush
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// FakePushProvider records all sent PushMsgs in memory, tokens in FailTokens fail with the mapped error
+type FakePushProvider struct {
+	ProviderName string
+	FailTokens   map[string]error
+
+	lock sync.Mutex
+	sent []*PushMsg
+}
+
+func NewFakePushProvider(name string) *FakePushProvider {
+	return &FakePushProvider{
+		ProviderName: name,
+		FailTokens:   map[string]error{},
+	}
+}
+
+func (p *FakePushProvider) Name() string {
+	return p.ProviderName
+}
+
+func (p *FakePushProvider) Send(ctx context.Context, pushMsg *PushMsg) []PushResult {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.sent = append(p.sent, pushMsg)
+
+	results := []PushResult{}
+	for _, target := range pushMsg.Targets {
+		results = append(results, PushResult{
+			Target: target,
+			Err:    p.FailTokens[target.Token],
+		})
+	}
+
+	log.Printf("FakePushProvider %v - recorded push to %v targets\n", p.ProviderName, len(pushMsg.Targets))
+	return results
+}
+
+// Sent returns the recorded PushMsgs
+func (p *FakePushProvider) Sent() []*PushMsg {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	sent := make([]*PushMsg, len(p.sent))
+	copy(sent, p.sent)
+	return sent
+}
+
+func (p *FakePushProvider) Reset() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.sent = nil
+}
+
+// NoopPushProvider drops all PushMsgs, used when a push service is disabled
+type NoopPushProvider struct {
+	ProviderName string
+}
+
+func (p *NoopPushProvider) Name() string {
+	return p.ProviderName
+}
+
+func (p *NoopPushProvider) Send(ctx context.Context, pushMsg *PushMsg) []PushResult {
+	return []PushResult{}
+}
+
+// FakePushRecorder records the payload paths and the failed results in memory
+type FakePushRecorder struct {
+	lock         sync.Mutex
+	payloadPaths map[string]int
+	failed       []PushResult
+}
+
+func NewFakePushRecorder() *FakePushRecorder {
+	return &FakePushRecorder{
+		payloadPaths: map[string]int{},
+	}
+}
+
+func (r *FakePushRecorder) RecordPayloadPath(providerName string, payloadPath string, count int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.payloadPaths[providerName+":"+payloadPath] += count
+}
+
+func (r *FakePushRecorder) RecordFailedResults(results []PushResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, result := range results {
+		if result.Err != nil && !IsRetryable(result.Err) {
+			r.failed = append(r.failed, result)
+		}
+	}
+}
+
+// PayloadPaths returns the number of targets sent on each payload path, by <provider>:<path>
+func (r *FakePushRecorder) PayloadPaths() map[string]int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	payloadPaths := map[string]int{}
+	for key, count := range r.payloadPaths {
+		payloadPaths[key] = count
+	}
+	return payloadPaths
+}
+
+// Failed returns the recorded non retryable failures
+func (r *FakePushRecorder) Failed() []PushResult {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	failed := make([]PushResult, len(r.failed))
+	copy(failed, r.failed)
+	return failed
+}
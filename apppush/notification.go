@@ -0,0 +1,258 @@
+package apppush
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSIPPb"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	LOCALE_EN = "en"
+	LOCALE_VI = "vi"
+
+	maxPreviewLength   = 100 //runes
+	maxCollapseKeySize = 64  //apns-collapse-id limit
+	defaultPushTTL     = 24 * 60 * 60
+)
+
+// notification text keys
+const (
+	textNewMessage   = "newMessage"
+	textSentPhoto    = "sentPhoto"
+	textSentPhotos   = "sentPhotos"
+	textSentVideo    = "sentVideo"
+	textSentAudio    = "sentAudio"
+	textSentFile     = "sentFile"
+	textSentContact  = "sentContact"
+	textSentEmoticon = "sentEmoticon"
+	textSentLink     = "sentLink"
+	textForwarded    = "forwarded"
+	textGroupUpdated = "groupUpdated"
+	textSenderInGrp  = "senderInGroup"
+)
+
+// notificationTexts - %[1]v is the sender name in senderInGroup, the count in sentPhotos
+var notificationTexts = map[string]map[string]string{
+	LOCALE_EN: {
+		textNewMessage:   "sent you a message",
+		textSentPhoto:    "sent a photo",
+		textSentPhotos:   "sent %[1]v photos",
+		textSentVideo:    "sent a video",
+		textSentAudio:    "sent a voice message",
+		textSentFile:     "sent a file",
+		textSentContact:  "shared a contact",
+		textSentEmoticon: "sent a sticker",
+		textSentLink:     "sent a link",
+		textForwarded:    "forwarded a message",
+		textGroupUpdated: "updated the group",
+		textSenderInGrp:  "%[1]v: %[2]v",
+	},
+	LOCALE_VI: {
+		textNewMessage:   "đã gửi cho bạn một tin nhắn",
+		textSentPhoto:    "đã gửi một ảnh",
+		textSentPhotos:   "đã gửi %[1]v ảnh",
+		textSentVideo:    "đã gửi một video",
+		textSentAudio:    "đã gửi một tin nhắn thoại",
+		textSentFile:     "đã gửi một tệp",
+		textSentContact:  "đã chia sẻ một liên hệ",
+		textSentEmoticon: "đã gửi một nhãn dán",
+		textSentLink:     "đã gửi một liên kết",
+		textForwarded:    "đã chuyển tiếp một tin nhắn",
+		textGroupUpdated: "đã cập nhật nhóm",
+		textSenderInGrp:  "%[1]v: %[2]v",
+	},
+}
+
+// PushNotification is the content of a visible notification, rendered in the locale of each target
+type PushNotification struct {
+	ImType     cwmSignalMsgPb.SIGNAL_IM_TYPE     `json:"imType"`
+	ThreadType cwmSignalMsgPb.SIGNAL_THREAD_TYPE `json:"threadType"`
+	ThreadId   string                            `json:"threadId"`
+	SenderName string                            `json:"senderName"`
+	GroupName  string                            `json:"groupName,omitempty"`
+	Preview    string                            `json:"preview,omitempty"` //text of IM msgs
+	MediaType  cwmSignalMsgPb.SIGNAL_MEDIA_TYPE  `json:"mediaType,omitempty"`
+	MediaCount int                               `json:"mediaCount,omitempty"`
+}
+
+type notificationConfig struct {
+	ttl         int64
+	priority    PUSH_PRIORITY
+	showPreview bool
+	locale      string
+}
+
+var (
+	notificationConfigInstance *notificationConfig
+	notificationConfigOnce     sync.Once
+)
+
+// getNotificationConfig - PUSH_TTL (seconds, default 1 day), PUSH_PRIORITY (high|normal, default high),
+// PUSH_SHOW_PREVIEW (default true) and PUSH_DEFAULT_LOCALE (default en)
+func getNotificationConfig() *notificationConfig {
+	notificationConfigOnce.Do(func() {
+		ttl, err := strconv.ParseInt(os.Getenv("PUSH_TTL"), 10, 64)
+		if err != nil || ttl < 0 {
+			ttl = defaultPushTTL
+		}
+
+		priority := PUSH_PRIORITY_HIGH
+		if os.Getenv("PUSH_PRIORITY") == "normal" {
+			priority = PUSH_PRIORITY_NORMAL
+		}
+
+		showPreview, err := strconv.ParseBool(os.Getenv("PUSH_SHOW_PREVIEW"))
+		if err != nil {
+			showPreview = true
+		}
+
+		locale := normalizeLocale(os.Getenv("PUSH_DEFAULT_LOCALE"))
+		if _, existed := notificationTexts[locale]; !existed {
+			locale = LOCALE_EN
+		}
+
+		notificationConfigInstance = &notificationConfig{
+			ttl:         ttl,
+			priority:    priority,
+			showPreview: showPreview,
+			locale:      locale,
+		}
+	})
+	return notificationConfigInstance
+}
+
+// NotificationTTL - seconds a notification is kept by the push service while the device is offline
+func NotificationTTL() int64 {
+	return getNotificationConfig().ttl
+}
+
+// NotificationPriority - priority of the visible notifications
+func NotificationPriority() PUSH_PRIORITY {
+	return getNotificationConfig().priority
+}
+
+// NewPushNotification builds the notification of a signal msg, nil if the msg should not show a notification
+func NewPushNotification(thread *model.SignalThread, header *cwmSIPPb.CWMRequestHeader, signalMessage *cwmSignalMsgPb.SignalMessage) *PushNotification {
+	if signalMessage.GetImType() <= cwmSignalMsgPb.SIGNAL_IM_TYPE_EVENT {
+		return nil
+	}
+
+	senderName := strings.TrimSpace(fmt.Sprintf("%v %v", header.GetFromFirstName(), header.GetFromLastName()))
+	if len(senderName) == 0 {
+		senderName = header.GetFromUserName()
+	}
+	if len(senderName) == 0 {
+		senderName = header.GetFrom()
+	}
+
+	notification := &PushNotification{
+		ImType:     signalMessage.GetImType(),
+		ThreadType: thread.Type,
+		ThreadId:   thread.ThreadId,
+		SenderName: senderName,
+		GroupName:  thread.GroupName,
+	}
+
+	switch signalMessage.GetImType() {
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_IM:
+		if getNotificationConfig().showPreview {
+			notification.Preview = truncate(string(signalMessage.GetData()), maxPreviewLength)
+		}
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_MULTIMEDIA:
+		multimediaMessage := &cwmSignalMsgPb.SignalMultimediaMessage{}
+		if proto.Unmarshal(signalMessage.GetData(), multimediaMessage) == nil && len(multimediaMessage.GetMultimediaFileInfos()) > 0 {
+			notification.MediaType = multimediaMessage.GetMultimediaFileInfos()[0].GetMediaType()
+			notification.MediaCount = len(multimediaMessage.GetMultimediaFileInfos())
+		}
+	}
+
+	return notification
+}
+
+// Render returns the title & body of the notification in the locale, falls back to PUSH_DEFAULT_LOCALE
+func (notification *PushNotification) Render(locale string) (string, string) {
+	texts, existed := notificationTexts[normalizeLocale(locale)]
+	if !existed {
+		texts = notificationTexts[getNotificationConfig().locale]
+	}
+
+	var body string
+	switch notification.ImType {
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_IM:
+		body = notification.Preview
+		if len(body) == 0 {
+			body = texts[textNewMessage]
+		}
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_MULTIMEDIA:
+		switch {
+		case notification.MediaCount == 0:
+			body = texts[textSentFile]
+		case notification.MediaType == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_IMAGE:
+			body = texts[textSentPhoto]
+			if notification.MediaCount > 1 {
+				body = fmt.Sprintf(texts[textSentPhotos], notification.MediaCount)
+			}
+		case notification.MediaType == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_VIDEO:
+			body = texts[textSentVideo]
+		case notification.MediaType == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_AUDIO:
+			body = texts[textSentAudio]
+		default:
+			body = texts[textSentFile]
+		}
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_CONTACT:
+		body = texts[textSentContact]
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_EMOTICON:
+		body = texts[textSentEmoticon]
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_URL:
+		body = texts[textSentLink]
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_FORWARD:
+		body = texts[textForwarded]
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_GROUP_THREAD_NOTIFICATION:
+		body = texts[textGroupUpdated]
+	default:
+		body = texts[textNewMessage]
+	}
+
+	if notification.ThreadType == cwmSignalMsgPb.SIGNAL_THREAD_TYPE_GROUP && len(notification.GroupName) > 0 {
+		return notification.GroupName, fmt.Sprintf(texts[textSenderInGrp], notification.SenderName, body)
+	}
+	return notification.SenderName, body
+}
+
+// CollapseKey - notifications of the same thread replace each other, so a burst of msgs shows (and wakes up) once
+func (notification *PushNotification) CollapseKey() string {
+	collapseKey := "thread_" + notification.ThreadId
+	if len(collapseKey) > maxCollapseKeySize {
+		hash := sha1.Sum([]byte(notification.ThreadId))
+		collapseKey = "thread_" + hex.EncodeToString(hash[:])
+	}
+	return collapseKey
+}
+
+// normalizeLocale - vi-VN, vi_VN => vi
+func normalizeLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if idx := strings.IndexAny(locale, "-_"); idx >= 0 {
+		locale = locale[:idx]
+	}
+	return locale
+}
+
+func truncate(text string, maxLength int) string {
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) <= maxLength {
+		return text
+	}
+
+	runes := []rune(text)
+	return string(runes[:maxLength]) + "…"
+}
@@ -0,0 +1,73 @@
+package apppush
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+const (
+	PUSH_STATS_KEY = "push:stats" //hash - <provider>:<path> - number of targets sent on each payload path
+
+	PUSH_PAYLOAD_PATH_FULL  = "full"  //the msg is in the payload
+	PUSH_PAYLOAD_PATH_FETCH = "fetch" //the payload is a fetch ping
+
+	maxPayloadSize     = 4 * 1024 //FCM data msg, APNs remote notification
+	maxVoIPPayloadSize = 5 * 1024 //APNs VoIP notification
+	//space reserved for the provider's own fields (aps, android config...)
+	payloadOverhead = 512
+)
+
+// fitsPayload estimates the payload size of the msg (data + rendered notification) against the push services' limits
+func fitsPayload(pushMsg *PushMsg) bool {
+	data, err := json.Marshal(pushMsg.Data())
+	if err != nil {
+		return false
+	}
+
+	size := len(data) + len(pushMsg.Title) + len(pushMsg.Body) + len(pushMsg.CollapseKey) + payloadOverhead
+
+	limit := maxPayloadSize
+	if pushMsg.VoIP {
+		limit = maxVoIPPayloadSize
+	}
+	return size <= limit
+}
+
+// applyPayloadStrategy sends the full msg when it fits, otherwise a fetch ping with threadId/msgId,
+// so the client calls FetchAllUnreceivedMsg. Returns the payload path
+func applyPayloadStrategy(pushMsg *PushMsg) string {
+	pushMsg.Fetch = false
+	if fitsPayload(pushMsg) {
+		return PUSH_PAYLOAD_PATH_FULL
+	}
+
+	pushMsg.Fetch = true
+	return PUSH_PAYLOAD_PATH_FETCH
+}
+
+func (storePushRecorder) RecordPayloadPath(providerName string, payloadPath string, count int) {
+	err := redisClient().HIncrBy(context.Background(), PUSH_STATS_KEY, providerName+":"+payloadPath, int64(count)).Err()
+	if err != nil {
+		log.Println("Push - record payload path error", err)
+	}
+}
+
+// PayloadStats returns the number of targets sent on each payload path, by <provider>:<path>
+func PayloadStats(ctx context.Context) (map[string]int64, error) {
+	values, err := redisClient().HGetAll(ctx, PUSH_STATS_KEY).Result()
+	if err != nil {
+		return nil, fmt.Errorf("(AppPush - PayloadStats): failed executing HGetAll -> %w", err)
+	}
+
+	stats := map[string]int64{}
+	for field, value := range values {
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err == nil {
+			stats[field] = count
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,108 @@
+package apppush
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sol.go/cwm/proto/grpcCWMPb"
+)
+
+type PUSH_PRIORITY int32
+
+const (
+	PUSH_PRIORITY_NORMAL PUSH_PRIORITY = 0 //silent / data only
+	PUSH_PRIORITY_HIGH   PUSH_PRIORITY = 1 //wake up the device
+
+	PUSH_PROVIDER_FCM     = "fcm"
+	PUSH_PROVIDER_APNS    = "apns"
+	PUSH_PROVIDER_WEBPUSH = "webpush"
+	PUSH_PROVIDER_FAKE    = "fake"
+	PUSH_PROVIDER_NONE    = "none"
+
+	DataMsgKey      = "msg"
+	DataFetchKey    = "fetch" //the msg is too big for the push payload, the client calls FetchAllUnreceivedMsg
+	DataThreadIdKey = "threadId"
+	DataMsgIdKey    = "msgId"
+)
+
+var (
+	// InvalidTokenErr is wrapped by providers when the push service reports the token as unregistered / bad
+	InvalidTokenErr = errors.New("Invalid push token")
+	// RetryableErr is wrapped by providers for transient failures (quota, 5xx...), the push job is retried with backoff
+	RetryableErr = errors.New("Retryable push error")
+)
+
+// PushTarget is a push token of a session
+type PushTarget struct {
+	PhoneFull string            `json:"phoneFull"`
+	SessionId string            `json:"sessionId"`
+	OsType    grpcCWMPb.OS_TYPE `json:"osType"`
+	Token     string            `json:"token"`
+	VoIP      bool              `json:"voip,omitempty"`     //iOS - Token is the APNS_VOIP token
+	BundleId  string            `json:"bundleId,omitempty"` //iOS BundleId
+	Locale    string            `json:"locale,omitempty"`
+	Badge     *int64            `json:"badge,omitempty"` //unread count of the user, nil - unknown
+	//WebPush - Token is the subscription endpoint
+	P256dh string `json:"p256dh,omitempty"`
+	Auth   string `json:"auth,omitempty"`
+}
+
+type PushMsg struct {
+	Targets       []PushTarget  `json:"targets"`
+	DataBase64    string        `json:"dataBase64"`
+	PriorityLevel PUSH_PRIORITY `json:"priorityLevel"`
+	VoIP          bool          `json:"voip,omitempty"` //iOS - Targets are APNS_VOIP tokens, set when the targets are split
+	ThreadId      string        `json:"threadId,omitempty"`
+	MsgId         string        `json:"msgId,omitempty"`
+
+	//visible notification, nil for data only msgs
+	Notification *PushNotification `json:"notification,omitempty"`
+	CollapseKey  string            `json:"collapseKey,omitempty"`
+	TTL          int64             `json:"ttl,omitempty"` //seconds, 0 - the provider's default
+
+	//rendered from Notification in the locale of the Targets when sending
+	Title string `json:"-"`
+	Body  string `json:"-"`
+	//set when sending if DataBase64 does not fit in the push payload
+	Fetch bool `json:"-"`
+}
+
+// Data is the custom data of the push payload: the msg itself, or a fetch ping if it does not fit
+func (pushMsg *PushMsg) Data() map[string]string {
+	if pushMsg.Fetch {
+		return map[string]string{
+			DataFetchKey:    "1",
+			DataThreadIdKey: pushMsg.ThreadId,
+			DataMsgIdKey:    pushMsg.MsgId,
+		}
+	}
+
+	return map[string]string{
+		DataMsgKey: pushMsg.DataBase64,
+	}
+}
+
+type PushResult struct {
+	Target PushTarget
+	Err    error
+}
+
+// PushProvider sends a PushMsg to a push service (FCM, APNs...), returns a PushResult per target
+type PushProvider interface {
+	Name() string
+	Send(ctx context.Context, pushMsg *PushMsg) []PushResult
+}
+
+// IsRetryable - failures marked with RetryableErr, timeouts and network errors are retried
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, RetryableErr) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,589 @@
+package apppush
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/go-redsync/redsync/v4"
+	"golang.org/x/exp/slices"
+	"log"
+	"math/rand"
+	"os"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/utils"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	PUSH_QUEUE_KEY            = "push:queue"      //list - jobs ready to send
+	PUSH_QUEUE_PROCESSING_KEY = "push:processing" //list per node - jobs being sent, moved back to the queue when the node restarts or dies
+	PUSH_QUEUE_NODES_KEY      = "push:nodes"      //set - nodes which own a processing list
+	PUSH_QUEUE_LEASE_KEY      = "push:lease"      //key per node - expires when the node stops renewing it
+	PUSH_QUEUE_RECLAIM_LOCK   = "push:reclaim"    //lock per dead node - only one node reclaims its processing list
+	PUSH_QUEUE_RETRY_KEY      = "push:retry"      //sorted set - jobs waiting for retry, score is the retry time (ms)
+	PUSH_QUEUE_DLQ_KEY        = "push:dlq"        //list - dead jobs
+	PUSH_QUEUE_RATE_KEY       = "push:rate"       //counter per provider per second
+
+	pushJobMaxAttempts  = 8
+	pushJobBaseBackoff  = 2 * time.Second
+	pushJobMaxBackoff   = 10 * time.Minute
+	pushQueuePopTimeout = time.Second
+	pushQueueWorkers    = 4 //default of PUSH_WORKERS
+	pushNodeLeaseTTL    = 30 * time.Second
+	pushNodeHeartbeat   = 10 * time.Second
+)
+
+// PushJob is a PushMsg whose targets have the same OsType, stored in redis as json
+type PushJob struct {
+	JobId     string   `json:"jobId"`
+	PushMsg   *PushMsg `json:"pushMsg"`
+	Attempt   int      `json:"attempt"`
+	CreatedAt int64    `json:"createdAt"`
+	LastError string   `json:"lastError,omitempty"`
+	DeadAt    int64    `json:"deadAt,omitempty"`
+}
+
+type PushQueueStats struct {
+	Queued     int64 `json:"queued"`
+	Processing int64 `json:"processing"`
+	Retrying   int64 `json:"retrying"`
+	Dead       int64 `json:"dead"`
+	//number of targets sent on each payload path, by <provider>:<full|fetch>
+	Payloads map[string]int64 `json:"payloads"`
+}
+
+// PushQueue is the durable push job queue: jobs are retried with exponential backoff on transient failures,
+// and moved to the dead-letter list when they run out of attempts or fail permanently
+type PushQueue struct {
+	appPush       *AppPush
+	nodeId        string
+	processingKey string
+	workers       int
+	rateLimits    map[string]int64 //provider - max targets per second, PUSH_RATE_LIMIT_<PROVIDER>
+
+	started       bool
+	stopOnce      sync.Once
+	stopCh        chan struct{}
+	workersDone   sync.WaitGroup
+	heartbeatStop chan struct{}
+	heartbeatDone chan struct{}
+}
+
+func newPushQueue(appPush *AppPush) *PushQueue {
+	nodeId := os.Getenv("NODE_ID")
+	if len(nodeId) == 0 {
+		nodeId, _ = os.Hostname()
+	}
+
+	workers, err := strconv.Atoi(os.Getenv("PUSH_WORKERS"))
+	if err != nil || workers <= 0 {
+		workers = pushQueueWorkers
+	}
+
+	rateLimits := map[string]int64{}
+	for _, providerName := range []string{PUSH_PROVIDER_FCM, PUSH_PROVIDER_APNS, PUSH_PROVIDER_WEBPUSH} {
+		rateLimit, _ := strconv.ParseInt(os.Getenv("PUSH_RATE_LIMIT_"+strings.ToUpper(providerName)), 10, 64)
+		rateLimits[providerName] = rateLimit
+	}
+
+	return &PushQueue{
+		appPush:       appPush,
+		nodeId:        nodeId,
+		processingKey: processingKeyOf(nodeId),
+		workers:       workers,
+		rateLimits:    rateLimits,
+		stopCh:        make(chan struct{}),
+		heartbeatStop: make(chan struct{}),
+		heartbeatDone: make(chan struct{}),
+	}
+}
+
+func processingKeyOf(nodeId string) string {
+	return fmt.Sprintf("%v:%v", PUSH_QUEUE_PROCESSING_KEY, nodeId)
+}
+
+func leaseKeyOf(nodeId string) string {
+	return fmt.Sprintf("%v:%v", PUSH_QUEUE_LEASE_KEY, nodeId)
+}
+
+func redisClient() *redis.Client {
+	return &dao.GetCache().RedisClient
+}
+
+func (q *PushQueue) Start() {
+	ctx := context.Background()
+	q.started = true
+	q.renewLease(ctx)
+
+	//jobs left in processing by the previous run of this node
+	count := q.requeueProcessing(ctx, q.processingKey)
+	if count > 0 {
+		log.Printf("Push queue - recovered %v processing jobs\n", count)
+	}
+
+	for i := 0; i < q.workers; i++ {
+		q.workersDone.Add(1)
+		go q.runWorker()
+	}
+
+	q.workersDone.Add(1)
+	go q.runRetryScheduler()
+
+	go q.runHeartbeat()
+}
+
+// Stop stops the workers after their current jobs, the lease of the node is renewed until then and released after
+func (q *PushQueue) Stop(ctx context.Context) error {
+	q.stopOnce.Do(func() {
+		close(q.stopCh)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		q.workersDone.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return fmt.Errorf("push queue - workers are not stopped: %w", ctx.Err())
+	}
+
+	if q.started {
+		close(q.heartbeatStop)
+		<-q.heartbeatDone
+		q.releaseLease(ctx)
+	}
+	return nil
+}
+
+// runHeartbeat renews the lease of the node, and reclaims the processing lists of the nodes whose lease expired
+func (q *PushQueue) runHeartbeat() {
+	defer close(q.heartbeatDone)
+
+	ticker := time.NewTicker(pushNodeHeartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-q.heartbeatStop:
+			return
+		case <-ticker.C:
+		}
+
+		ctx := context.Background()
+		q.renewLease(ctx)
+		q.reclaimDeadNodes(ctx)
+	}
+}
+
+func (q *PushQueue) renewLease(ctx context.Context) {
+	pipe := redisClient().TxPipeline()
+	pipe.Set(ctx, leaseKeyOf(q.nodeId), time.Now().UnixMilli(), pushNodeLeaseTTL)
+	pipe.SAdd(ctx, PUSH_QUEUE_NODES_KEY, q.nodeId)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		log.Println("Push queue - renew lease error", err)
+	}
+}
+
+// releaseLease - the processing list of a stopped node is empty, the node is unregistered
+func (q *PushQueue) releaseLease(ctx context.Context) {
+	count := q.requeueProcessing(ctx, q.processingKey)
+	if count > 0 {
+		log.Printf("Push queue - requeued %v processing jobs\n", count)
+	}
+
+	pipe := redisClient().TxPipeline()
+	pipe.Del(ctx, leaseKeyOf(q.nodeId))
+	pipe.SRem(ctx, PUSH_QUEUE_NODES_KEY, q.nodeId)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		log.Println("Push queue - release lease error", err)
+	}
+}
+
+// reclaimDeadNodes moves the jobs left in processing by the nodes which stopped renewing their lease
+// (crashed, or replaced by a rollout with a new NODE_ID) back to the queue. A job of a node which only
+// missed its heartbeats may be sent twice, a push is delivered at least once
+func (q *PushQueue) reclaimDeadNodes(ctx context.Context) {
+	nodeIds, err := redisClient().SMembers(ctx, PUSH_QUEUE_NODES_KEY).Result()
+	if err != nil {
+		log.Println("Push queue - list nodes error", err)
+		return
+	}
+
+	for _, nodeId := range nodeIds {
+		if nodeId == q.nodeId {
+			continue
+		}
+
+		alive, err := redisClient().Exists(ctx, leaseKeyOf(nodeId)).Result()
+		if err != nil || alive > 0 {
+			continue
+		}
+
+		mutex := dao.GetCache().RedSync.NewMutex(fmt.Sprintf("%v:%v", PUSH_QUEUE_RECLAIM_LOCK, nodeId),
+			redsync.WithExpiry(pushNodeLeaseTTL), redsync.WithTries(1))
+		if mutex.LockContext(ctx) != nil {
+			continue
+		}
+
+		count := q.requeueProcessing(ctx, processingKeyOf(nodeId))
+		//the node may be back meanwhile, it registers again with its next lease
+		err = redisClient().SRem(ctx, PUSH_QUEUE_NODES_KEY, nodeId).Err()
+		if err != nil {
+			log.Println("Push queue - unregister node error", nodeId, err)
+		}
+		_, _ = mutex.UnlockContext(ctx)
+
+		log.Printf("Push queue - reclaimed %v processing jobs of dead node %v\n", count, nodeId)
+	}
+}
+
+// requeueProcessing moves the jobs of a processing list back to the queue
+func (q *PushQueue) requeueProcessing(ctx context.Context, processingKey string) int {
+	count := 0
+	for {
+		_, err := redisClient().RPopLPush(ctx, processingKey, PUSH_QUEUE_KEY).Result()
+		if err != nil {
+			if err != redis.Nil {
+				log.Println("Push queue - requeue processing jobs error", processingKey, err)
+			}
+			return count
+		}
+		count++
+	}
+}
+
+func (q *PushQueue) isStopped() bool {
+	select {
+	case <-q.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+func (q *PushQueue) Enqueue(ctx context.Context, pushMsg *PushMsg) error {
+	if len(pushMsg.Targets) == 0 {
+		return nil
+	}
+
+	job := &PushJob{
+		JobId:     utils.GenerateUUID(),
+		PushMsg:   pushMsg,
+		CreatedAt: time.Now().UnixMilli(),
+	}
+	return q.pushJob(ctx, PUSH_QUEUE_KEY, job)
+}
+
+func (q *PushQueue) pushJob(ctx context.Context, key string, job *PushJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("(PushQueue - pushJob): failed marshaling job -> %w", err)
+	}
+
+	err = redisClient().LPush(ctx, key, data).Err()
+	if err != nil {
+		return fmt.Errorf("(PushQueue - pushJob): failed executing LPush -> %w", err)
+	}
+	return nil
+}
+
+func (q *PushQueue) runWorker() {
+	defer q.workersDone.Done()
+
+	for !q.isStopped() {
+		//not cancelled by stop, so a popped job is never left in processing
+		raw, err := redisClient().BRPopLPush(context.Background(), PUSH_QUEUE_KEY, q.processingKey, pushQueuePopTimeout).Result()
+		if err != nil {
+			if err != redis.Nil {
+				log.Println("Push queue - pop job error", err)
+				time.Sleep(pushQueuePopTimeout)
+			}
+			continue
+		}
+
+		q.processJob(raw)
+
+		err = redisClient().LRem(context.Background(), q.processingKey, 1, raw).Err()
+		if err != nil {
+			log.Println("Push queue - remove processing job error", err)
+		}
+	}
+}
+
+func (q *PushQueue) processJob(raw string) {
+	job := &PushJob{}
+	err := json.Unmarshal([]byte(raw), job)
+	if err != nil || job.PushMsg == nil || len(job.PushMsg.Targets) == 0 {
+		log.Println("Push queue - drop invalid job", err)
+		return
+	}
+
+	provider := q.appPush.GetProvider(job.PushMsg.Targets[0].OsType)
+	if provider != nil {
+		q.waitRateLimit(provider.Name(), int64(len(job.PushMsg.Targets)))
+	}
+
+	results := q.appPush.send(job.PushMsg)
+	q.appPush.recorder.RecordFailedResults(results)
+
+	retryTargets := []PushTarget{}
+	deadTargets := []PushTarget{}
+	var retryErr, deadErr error
+	for _, result := range results {
+		if result.Err == nil || errors.Is(result.Err, InvalidTokenErr) {
+			continue
+		}
+
+		if IsRetryable(result.Err) {
+			retryTargets = append(retryTargets, result.Target)
+			retryErr = result.Err
+		} else {
+			deadTargets = append(deadTargets, result.Target)
+			deadErr = result.Err
+		}
+	}
+
+	if len(retryTargets) > 0 {
+		retryJob := job.withTargets(retryTargets, retryErr)
+		retryJob.Attempt++
+		if retryJob.Attempt >= pushJobMaxAttempts {
+			q.moveToDeadLetter(retryJob)
+		} else {
+			q.scheduleRetry(retryJob)
+		}
+	}
+
+	if len(deadTargets) > 0 {
+		q.moveToDeadLetter(job.withTargets(deadTargets, deadErr))
+	}
+}
+
+func (job *PushJob) withTargets(targets []PushTarget, err error) *PushJob {
+	pushMsg := *job.PushMsg
+	pushMsg.Targets = targets
+
+	newJob := *job
+	newJob.PushMsg = &pushMsg
+	newJob.LastError = err.Error()
+	return &newJob
+}
+
+// waitRateLimit blocks until the provider's per second budget allows sending the targets
+func (q *PushQueue) waitRateLimit(providerName string, count int64) {
+	rateLimit := q.rateLimits[providerName]
+	if rateLimit <= 0 {
+		return
+	}
+
+	for !q.isStopped() {
+		now := time.Now()
+		key := fmt.Sprintf("%v:%v:%v", PUSH_QUEUE_RATE_KEY, providerName, now.Unix())
+
+		used, err := redisClient().IncrBy(context.Background(), key, count).Result()
+		if err != nil {
+			log.Println("Push queue - rate limit error", err)
+			return
+		}
+		redisClient().Expire(context.Background(), key, 2*time.Second)
+
+		//a job bigger than the budget still goes out alone in a fresh second
+		if used <= rateLimit || used == count {
+			return
+		}
+
+		time.Sleep(now.Truncate(time.Second).Add(time.Second).Sub(now))
+	}
+}
+
+func (q *PushQueue) scheduleRetry(job *PushJob) {
+	backoff := pushJobBaseBackoff << (job.Attempt - 1)
+	if backoff > pushJobMaxBackoff || backoff <= 0 {
+		backoff = pushJobMaxBackoff
+	}
+	backoff += time.Duration(rand.Int63n(int64(backoff) / 5)) //jitter, so retries of a blip are spread
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		log.Println("Push queue - marshal retry job error", err)
+		return
+	}
+
+	retryAt := time.Now().Add(backoff).UnixMilli()
+	err = redisClient().ZAdd(context.Background(), PUSH_QUEUE_RETRY_KEY, &redis.Z{Score: float64(retryAt), Member: data}).Err()
+	if err != nil {
+		log.Println("Push queue - schedule retry error", err)
+		return
+	}
+
+	log.Printf("Push queue - retry job %v (attempt %v) in %v: %v\n", job.JobId, job.Attempt, backoff, job.LastError)
+}
+
+func (q *PushQueue) moveToDeadLetter(job *PushJob) {
+	job.DeadAt = time.Now().UnixMilli()
+	err := q.pushJob(context.Background(), PUSH_QUEUE_DLQ_KEY, job)
+	if err != nil {
+		log.Println("Push queue - move to dead letter error", err)
+		return
+	}
+
+	log.Printf("Push queue - dead job %v of %v targets: %v\n", job.JobId, len(job.PushMsg.Targets), job.LastError)
+}
+
+// runRetryScheduler moves the due retry jobs back to the queue
+func (q *PushQueue) runRetryScheduler() {
+	defer q.workersDone.Done()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-q.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		ctx := context.Background()
+		dueJobs, err := redisClient().ZRangeByScore(ctx, PUSH_QUEUE_RETRY_KEY, &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   strconv.FormatInt(time.Now().UnixMilli(), 10),
+			Count: 100,
+		}).Result()
+		if err != nil {
+			log.Println("Push queue - fetch retry jobs error", err)
+			continue
+		}
+
+		for _, raw := range dueJobs {
+			//only the node which removes the job re-queues it
+			removed, err := redisClient().ZRem(ctx, PUSH_QUEUE_RETRY_KEY, raw).Result()
+			if err != nil || removed == 0 {
+				continue
+			}
+
+			err = redisClient().LPush(ctx, PUSH_QUEUE_KEY, raw).Err()
+			if err != nil {
+				log.Println("Push queue - requeue retry job error", err)
+			}
+		}
+	}
+}
+
+// Stats - Processing counts the jobs of all the registered nodes
+func (q *PushQueue) Stats(ctx context.Context) (*PushQueueStats, error) {
+	nodeIds, err := redisClient().SMembers(ctx, PUSH_QUEUE_NODES_KEY).Result()
+	if err != nil {
+		return nil, fmt.Errorf("(PushQueue - Stats): failed executing SMembers -> %w", err)
+	}
+	if !slices.Contains(nodeIds, q.nodeId) {
+		nodeIds = append(nodeIds, q.nodeId)
+	}
+
+	pipe := redisClient().Pipeline()
+	queued := pipe.LLen(ctx, PUSH_QUEUE_KEY)
+	processingCmds := []*redis.IntCmd{}
+	for _, nodeId := range nodeIds {
+		processingCmds = append(processingCmds, pipe.LLen(ctx, processingKeyOf(nodeId)))
+	}
+	retrying := pipe.ZCard(ctx, PUSH_QUEUE_RETRY_KEY)
+	dead := pipe.LLen(ctx, PUSH_QUEUE_DLQ_KEY)
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("(PushQueue - Stats): failed executing pipeline -> %w", err)
+	}
+
+	payloads, err := PayloadStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var processing int64 = 0
+	for _, processingCmd := range processingCmds {
+		processing += processingCmd.Val()
+	}
+
+	return &PushQueueStats{
+		Queued:     queued.Val(),
+		Processing: processing,
+		Retrying:   retrying.Val(),
+		Dead:       dead.Val(),
+		Payloads:   payloads,
+	}, nil
+}
+
+// ListDeadJobs returns the dead jobs from newest, start/stop are list indexes (inclusive)
+func (q *PushQueue) ListDeadJobs(ctx context.Context, start, stop int64) ([]*PushJob, error) {
+	raws, err := redisClient().LRange(ctx, PUSH_QUEUE_DLQ_KEY, start, stop).Result()
+	if err != nil {
+		return nil, fmt.Errorf("(PushQueue - ListDeadJobs): failed executing LRange -> %w", err)
+	}
+
+	jobs := []*PushJob{}
+	for _, raw := range raws {
+		job := &PushJob{}
+		if json.Unmarshal([]byte(raw), job) == nil {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+// ReplayDeadJobs moves the dead job with jobId (all dead jobs if jobId is empty) back to the queue with fresh attempts
+func (q *PushQueue) ReplayDeadJobs(ctx context.Context, jobId string) (int64, error) {
+	raws, err := redisClient().LRange(ctx, PUSH_QUEUE_DLQ_KEY, 0, -1).Result()
+	if err != nil {
+		return 0, fmt.Errorf("(PushQueue - ReplayDeadJobs): failed executing LRange -> %w", err)
+	}
+
+	var count int64 = 0
+	for _, raw := range raws {
+		job := &PushJob{}
+		if json.Unmarshal([]byte(raw), job) != nil {
+			continue
+		}
+		if len(jobId) > 0 && job.JobId != jobId {
+			continue
+		}
+
+		removed, err := redisClient().LRem(ctx, PUSH_QUEUE_DLQ_KEY, 1, raw).Result()
+		if err != nil {
+			return count, fmt.Errorf("(PushQueue - ReplayDeadJobs): failed executing LRem -> %w", err)
+		}
+		if removed == 0 { //replayed by someone else
+			continue
+		}
+
+		job.Attempt = 0
+		job.DeadAt = 0
+		err = q.pushJob(ctx, PUSH_QUEUE_KEY, job)
+		if err != nil {
+			return count, fmt.Errorf("(PushQueue - ReplayDeadJobs): failed executing pushJob -> %w", err)
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+func (q *PushQueue) PurgeDeadJobs(ctx context.Context) (int64, error) {
+	count, err := redisClient().LLen(ctx, PUSH_QUEUE_DLQ_KEY).Result()
+	if err != nil {
+		return 0, fmt.Errorf("(PushQueue - PurgeDeadJobs): failed executing LLen -> %w", err)
+	}
+
+	err = redisClient().Del(ctx, PUSH_QUEUE_DLQ_KEY).Err()
+	if err != nil {
+		return 0, fmt.Errorf("(PushQueue - PurgeDeadJobs): failed executing Del -> %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,308 @@
+package appupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-redsync/redsync/v4"
+	"go.mongodb.org/mongo-driver/mongo"
+	"log"
+	"sol.go/cwm/blobstore"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/utils"
+	"time"
+)
+
+var (
+	BlobDeletedErr = errors.New("File was deleted during the upload")
+)
+
+// LinkExistingFile creates a S3FileInfo of the msg sent by the uploader, pointing to the stored blob of the same content, without any upload.
+// Returns nil if the content is not stored yet
+func LinkExistingFile(ctx context.Context, uploader string, msgId string, threadId string, mediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE, checksum string, fileSize int64) (*model.S3FileInfo, error) {
+	err := ValidateChecksum(checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	err = BindMediaMsg(ctx, uploader, msgId, threadId)
+	if err != nil {
+		return nil, err
+	}
+
+	blobRef, err := dao.GetBlobRefDAO().IncreaseByContent(ctx, checksum, fileSize)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if blobRef.ScanStatus == model.SCAN_STATUS_BLOCKED {
+		releaseBlob(ctx, blobRef.BlobKey)
+		return nil, fmt.Errorf("%w: %s", MediaBlockedErr, blobRef.BlockedReason)
+	}
+
+	err = ValidateMediaMimetype(mediaType, blobRef.MimeType)
+	if err != nil {
+		releaseBlob(ctx, blobRef.BlobKey)
+		return nil, err
+	}
+
+	storageQuota := GetStorageQuota()
+	err = storageQuota.AddFile(ctx, uploader, blobRef.FileSize)
+	if err != nil {
+		releaseBlob(ctx, blobRef.BlobKey)
+		return nil, err
+	}
+
+	s3FileInfo := &model.S3FileInfo{
+		FileId:    utils.GenerateUUID(),
+		MsgId:     msgId,
+		ThreadId:  threadId,
+		Uploader:  uploader,
+		FileName:  blobRef.BlobKey,
+		FileSize:  blobRef.FileSize,
+		Checksum:  blobRef.Checksum,
+		MediaType: mediaType,
+		Variants:  blobRef.Variants,
+		CreatedAt: time.Now().UnixMilli(),
+
+		OriginalChecksum: checksum,
+		OriginalFileSize: fileSize,
+		ScanStatus:       initialScanStatus(mediaType, blobRef),
+	}
+	s3FileInfo, err = dao.GetS3FileInfoDAO().Save(ctx, s3FileInfo)
+	if err != nil {
+		storageQuota.RemoveFile(ctx, uploader, blobRef.FileSize)
+		releaseBlob(ctx, blobRef.BlobKey)
+		return nil, fmt.Errorf("cannot save s3FileInfo: %w", err)
+	}
+
+	GenerateVariantsAsync(s3FileInfo)
+	ScanFileAsync(s3FileInfo)
+	return s3FileInfo, nil
+}
+
+// SaveFileInfo adds a reference to the uploaded blob then saves its S3FileInfo, the variants of an image are generated and the docs are scanned after.
+// The file is counted in the quota of its uploader, the blob is deleted if it does not fit or if the content was already blocked by a scan
+func SaveFileInfo(ctx context.Context, s3FileInfo *model.S3FileInfo, mimeType string) (*model.S3FileInfo, error) {
+	redLock, err := lockBlob(ctx, s3FileInfo.FileName)
+	if err != nil {
+		return nil, err
+	}
+	defer redLock.Unlock()
+
+	storageQuota := GetStorageQuota()
+	if len(s3FileInfo.Uploader) > 0 {
+		err = storageQuota.AddFile(ctx, s3FileInfo.Uploader, s3FileInfo.FileSize)
+		if err != nil {
+			if errors.Is(err, StorageQuotaExceededErr) {
+				discardErr := discard(ctx, s3FileInfo.FileName)
+				if discardErr != nil {
+					log.Println("SaveFileInfo - cannot delete blob", s3FileInfo.FileName, discardErr)
+				}
+			}
+			return nil, err
+		}
+	}
+	//the file is not stored
+	removeFile := func() {
+		if len(s3FileInfo.Uploader) > 0 {
+			storageQuota.RemoveFile(ctx, s3FileInfo.Uploader, s3FileInfo.FileSize)
+		}
+	}
+
+	blobRefDAO := dao.GetBlobRefDAO()
+	blobRef, err := blobRefDAO.IncreaseByBlobKey(ctx, &model.BlobRef{
+		BlobKey:  s3FileInfo.FileName,
+		Checksum: s3FileInfo.Checksum,
+		FileSize: s3FileInfo.FileSize,
+		MimeType: mimeType,
+
+		OriginalChecksum: s3FileInfo.OriginalChecksum,
+		OriginalFileSize: s3FileInfo.OriginalFileSize,
+	})
+	if err != nil {
+		removeFile()
+		return nil, err
+	}
+
+	//the content was uploaded again, its quarantined copy is kept
+	if blobRef.ScanStatus == model.SCAN_STATUS_BLOCKED {
+		removeFile()
+		release(ctx, s3FileInfo.FileName)
+		err = blobstore.GetBlobStore().Delete(ctx, s3FileInfo.FileName)
+		if err != nil {
+			log.Println("SaveFileInfo - cannot delete blob", s3FileInfo.FileName, err)
+		}
+		return nil, fmt.Errorf("%w: %s", MediaBlockedErr, blobRef.BlockedReason)
+	}
+	s3FileInfo.ScanStatus = initialScanStatus(s3FileInfo.MediaType, blobRef)
+
+	//a skipped upload relies on a blob which may have been released in the meantime
+	_, err = blobstore.GetBlobStore().Stat(ctx, s3FileInfo.FileName)
+	if err != nil {
+		removeFile()
+		release(ctx, s3FileInfo.FileName)
+		if errors.Is(err, blobstore.BlobNotFoundErr) {
+			return nil, fmt.Errorf("%w: %s", BlobDeletedErr, s3FileInfo.FileName)
+		}
+		return nil, err
+	}
+
+	savedFileInfo, err := dao.GetS3FileInfoDAO().Save(ctx, s3FileInfo)
+	if err != nil {
+		removeFile()
+		release(ctx, s3FileInfo.FileName)
+		return nil, fmt.Errorf("cannot save s3FileInfo: %w", err)
+	}
+
+	GenerateVariantsAsync(savedFileInfo)
+	ScanFileAsync(savedFileInfo)
+	return savedFileInfo, nil
+}
+
+// ReleaseFile deletes the S3FileInfo and gives its size back to the quota of the uploader, its blob is deleted once no S3FileInfo refers to it.
+// Returns the size of the deleted blob and variants, 0 if the blob is still referred
+func ReleaseFile(ctx context.Context, fileId string) (int64, error) {
+	s3FileInfo, err := dao.GetS3FileInfoDAO().FindByFileId(ctx, fileId)
+	if err != nil {
+		return 0, err
+	}
+
+	//the shared media of the thread does not list a file which can not be downloaded anymore
+	_, err = dao.GetThreadMediaDAO().DeleteByFileId(ctx, s3FileInfo.MsgId, fileId)
+	if err != nil {
+		return 0, err
+	}
+
+	s3FileInfo, err = dao.GetS3FileInfoDAO().DeleteByFileId(ctx, fileId)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(s3FileInfo.Uploader) > 0 {
+		err = GetStorageQuota().RemoveFile(ctx, s3FileInfo.Uploader, s3FileInfo.FileSize)
+		if err != nil {
+			log.Println("ReleaseFile - cannot update storage usage", s3FileInfo.Uploader, err)
+		}
+	}
+
+	return releaseBlob(ctx, s3FileInfo.FileName)
+}
+
+func releaseBlob(ctx context.Context, blobKey string) (int64, error) {
+	redLock, err := lockBlob(ctx, blobKey)
+	if err != nil {
+		return 0, err
+	}
+	defer redLock.Unlock()
+
+	return release(ctx, blobKey)
+}
+
+// release drops a reference to the blob, the lock of the blob must be held.
+// Returns the size of the deleted blob and variants
+func release(ctx context.Context, blobKey string) (int64, error) {
+	blobRefDAO := dao.GetBlobRefDAO()
+	blobRef, err := blobRefDAO.DecreaseByBlobKey(ctx, blobKey)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, err
+	}
+	if blobRef != nil && blobRef.RefCount > 0 {
+		return 0, nil
+	}
+
+	//the blobs uploaded before the BlobRefs are only referred by their S3FileInfo
+	count, err := dao.GetS3FileInfoDAO().CountByFileName(ctx, blobKey)
+	if err != nil {
+		return 0, err
+	}
+	if count > 0 {
+		if blobRef != nil {
+			return 0, blobRefDAO.SetRefCount(ctx, blobKey, count)
+		}
+		return 0, nil
+	}
+
+	blobStore := blobstore.GetBlobStore()
+	reclaimed := int64(0)
+	if blobRef != nil {
+		deleted, err := blobRefDAO.DeleteUnreferenced(ctx, blobKey)
+		if err != nil || !deleted {
+			return 0, err
+		}
+		deleteVariants(ctx, blobRef.Variants)
+		if len(blobRef.QuarantineKey) > 0 {
+			err = blobStore.Delete(ctx, blobRef.QuarantineKey)
+			if err != nil {
+				log.Println("Cannot delete quarantined blob", blobRef.QuarantineKey, err)
+			}
+		}
+
+		reclaimed = blobRef.FileSize
+		for _, variant := range blobRef.Variants {
+			reclaimed += variant.FileSize
+		}
+	} else {
+		blobInfo, err := blobStore.Stat(ctx, blobKey)
+		if err != nil {
+			if errors.Is(err, blobstore.BlobNotFoundErr) {
+				return 0, nil
+			}
+			return 0, err
+		}
+		reclaimed = blobInfo.Size
+	}
+
+	log.Println("Delete unreferenced blob", blobKey)
+	err = blobStore.Delete(ctx, blobKey)
+	if err != nil {
+		return 0, err
+	}
+	return reclaimed, nil
+}
+
+func discardBlob(ctx context.Context, blobKey string) error {
+	redLock, err := lockBlob(ctx, blobKey)
+	if err != nil {
+		return err
+	}
+	defer redLock.Unlock()
+
+	return discard(ctx, blobKey)
+}
+
+// discard deletes an uploaded blob unless it is referred, the lock of the blob must be held
+func discard(ctx context.Context, blobKey string) error {
+	blobRef, err := dao.GetBlobRefDAO().FindByBlobKey(ctx, blobKey)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	if blobRef != nil && blobRef.RefCount > 0 {
+		return nil
+	}
+
+	count, err := dao.GetS3FileInfoDAO().CountByFileName(ctx, blobKey)
+	if err != nil || count > 0 {
+		return err
+	}
+
+	return blobstore.GetBlobStore().Delete(ctx, blobKey)
+}
+
+// lockBlob - the references of a blob are changed one at a time
+func lockBlob(ctx context.Context, blobKey string) (*redsync.Mutex, error) {
+	blobRefDAO := dao.GetBlobRefDAO()
+	mutexName := fmt.Sprintf("%v_%v", blobRefDAO.CollectionName, blobKey)
+
+	redLock := blobRefDAO.CreateRedlock(ctx, mutexName, blobRefDAO.CacheLockTTL)
+	err := redLock.Lock()
+	if err != nil {
+		return nil, fmt.Errorf("cannot lock blob %v: %w", blobKey, err)
+	}
+	return redLock, nil
+}
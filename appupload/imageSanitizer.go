@@ -0,0 +1,484 @@
+package appupload
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"sol.go/cwm/blobstore"
+	"strconv"
+	"sync"
+)
+
+const (
+	exifOrientationTag = 0x0112
+	maxSanitizeSize    = 20 * 1024 << 10 //20 MB - the image and its sanitized copy are kept in memory
+	sanitizeWorkers    = 2
+)
+
+var (
+	InvalidImageDataErr = errors.New("Invalid image data")
+	ImageTooLargeErr    = fmt.Errorf("Image too large, the jpeg/png/webp images are limited to %d MB", maxSanitizeSize>>20)
+
+	//bounds the memory of the sanitized images: sanitizeWorkers * 2 * maxSanitizeSize
+	sanitizeSlots = make(chan struct{}, sanitizeWorkers)
+
+	exifHeader   = []byte("Exif\x00\x00")
+	pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+	sanitizeEnabled     bool
+	onceSanitizeEnabled sync.Once
+)
+
+// ImageSanitizeEnabled reads IMAGE_SANITIZE (default true), the sanitized images are limited to maxSanitizeSize
+func ImageSanitizeEnabled() bool {
+	onceSanitizeEnabled.Do(func() {
+		sanitizeEnabled = true
+		value := os.Getenv("IMAGE_SANITIZE")
+		if len(value) > 0 {
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				log.Println("Invalid IMAGE_SANITIZE", value)
+			} else {
+				sanitizeEnabled = enabled
+			}
+		}
+	})
+	return sanitizeEnabled
+}
+
+// CanSanitizeImage - the images of other types are stored as they are received
+func CanSanitizeImage(mimeType string) bool {
+	switch mimeType {
+	case "image/jpeg", "image/png", "image/webp":
+		return true
+	}
+	return false
+}
+
+// SanitizeImage removes the metadata which can identify the location or the device (EXIF, XMP, IPTC, comments),
+// only the EXIF orientation is kept
+func SanitizeImage(data []byte, mimeType string) ([]byte, error) {
+	switch mimeType {
+	case "image/jpeg":
+		return sanitizeJPEG(data)
+	case "image/png":
+		return sanitizePNG(data)
+	case "image/webp":
+		return sanitizeWebP(data)
+	}
+	return data, nil
+}
+
+type sanitizedImage struct {
+	FileName string
+	Checksum string
+	FileSize int64
+}
+
+// sanitizeStoredImage stores the image of the blob without its metadata, a few images are sanitized at a time
+func sanitizeStoredImage(ctx context.Context, blobKey string, mimeType string, namePrefix string, extension string) (*sanitizedImage, error) {
+	select {
+	case sanitizeSlots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-sanitizeSlots }()
+
+	body, _, err := blobstore.GetBlobStore().Get(ctx, blobKey)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxSanitizeSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read image: %w", err)
+	}
+	if len(data) > maxSanitizeSize {
+		return nil, ImageTooLargeErr
+	}
+
+	return storeSanitizedImage(ctx, data, mimeType, namePrefix, extension)
+}
+
+// storeSanitizedImage stores the image without its metadata, named from the sanitized content like the other blobs
+func storeSanitizedImage(ctx context.Context, data []byte, mimeType string, namePrefix string, extension string) (*sanitizedImage, error) {
+	data, err := SanitizeImage(data, mimeType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", InvalidImageErr, err)
+	}
+
+	checksum := fmt.Sprintf("%x", md5.Sum(data))
+	image := &sanitizedImage{
+		FileName: fmt.Sprintf("%s%s%s", namePrefix, checksum, extension),
+		Checksum: checksum,
+		FileSize: int64(len(data)),
+	}
+
+	blobStore := blobstore.GetBlobStore()
+	_, err = blobStore.Stat(ctx, image.FileName)
+	if err == nil {
+		return image, nil
+	}
+	if !errors.Is(err, blobstore.BlobNotFoundErr) {
+		return nil, fmt.Errorf("cannot save file: %w", err)
+	}
+
+	blobInfo, err := blobStore.Put(ctx, image.FileName, bytes.NewReader(data), mimeType)
+	if err != nil {
+		return nil, fmt.Errorf("cannot save file: %w", err)
+	}
+	log.Println("Done Upload File... etag:", blobInfo.ETag)
+	return image, nil
+}
+
+// sanitizeJPEG drops the APP1 (EXIF/XMP), APP13 (IPTC), APP2 MPF segments, the comments and the data after EOI (MPF secondary images)
+func sanitizeJPEG(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, fmt.Errorf("%w: missing jpeg SOI", InvalidImageDataErr)
+	}
+
+	out := bytes.Buffer{}
+	out.Write(data[:2])
+
+	pos := 2
+	for pos < len(data) {
+		if data[pos] != 0xFF {
+			return nil, fmt.Errorf("%w: invalid jpeg marker at %d", InvalidImageDataErr, pos)
+		}
+		//fill bytes
+		for pos < len(data) && data[pos] == 0xFF {
+			pos++
+		}
+		if pos >= len(data) {
+			break
+		}
+		marker := data[pos]
+		pos++
+
+		switch {
+		case marker == 0xD9: //EOI
+			out.Write([]byte{0xFF, marker})
+			return out.Bytes(), nil
+		case marker >= 0xD0 && marker <= 0xD7, marker == 0x01: //no length
+			out.Write([]byte{0xFF, marker})
+			continue
+		}
+
+		if pos+2 > len(data) {
+			return nil, fmt.Errorf("%w: truncated jpeg segment", InvalidImageDataErr)
+		}
+		length := int(binary.BigEndian.Uint16(data[pos:]))
+		if length < 2 || pos+length > len(data) {
+			return nil, fmt.Errorf("%w: invalid jpeg segment length", InvalidImageDataErr)
+		}
+		payload := data[pos+2 : pos+length]
+		segment := data[pos-2 : pos+length]
+		pos += length
+
+		switch {
+		case marker == 0xE1: //APP1 - EXIF / XMP
+			if bytes.HasPrefix(payload, exifHeader) {
+				orientation := exifOrientation(payload[len(exifHeader):])
+				if orientation > 1 {
+					exif := append(append([]byte{}, exifHeader...), orientationTIFF(orientation)...)
+					out.Write([]byte{0xFF, 0xE1})
+					binary.Write(&out, binary.BigEndian, uint16(len(exif)+2))
+					out.Write(exif)
+				}
+			}
+		case marker == 0xED, marker == 0xFE: //APP13 - IPTC, COM
+		case marker == 0xE2 && bytes.HasPrefix(payload, []byte("MPF\x00")):
+		case marker == 0xDA: //SOS - copy the entropy coded data until the next marker
+			out.Write(segment)
+			start := pos
+			for pos+1 < len(data) {
+				if data[pos] == 0xFF && data[pos+1] != 0x00 && !(data[pos+1] >= 0xD0 && data[pos+1] <= 0xD7) {
+					break
+				}
+				pos++
+			}
+			if pos+1 >= len(data) {
+				pos = len(data)
+			}
+			out.Write(data[start:pos])
+		default:
+			out.Write(segment)
+		}
+	}
+
+	//truncated after the image data, kept as the decoders do
+	return out.Bytes(), nil
+}
+
+// sanitizePNG drops the text and time chunks, the eXIf chunk only keeps the orientation
+func sanitizePNG(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, fmt.Errorf("%w: missing png signature", InvalidImageDataErr)
+	}
+
+	out := bytes.Buffer{}
+	out.Write(pngSignature)
+
+	pos := len(pngSignature)
+	for pos+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		chunkType := string(data[pos+4 : pos+8])
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return nil, fmt.Errorf("%w: invalid png chunk length", InvalidImageDataErr)
+		}
+		chunk := data[pos:end]
+		pos = end
+
+		switch chunkType {
+		case "tEXt", "zTXt", "iTXt", "tIME":
+		case "eXIf":
+			orientation := exifOrientation(chunk[8 : 8+length])
+			if orientation > 1 {
+				writePNGChunk(&out, "eXIf", orientationTIFF(orientation))
+			}
+		default:
+			out.Write(chunk)
+		}
+
+		if chunkType == "IEND" {
+			return out.Bytes(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: missing png IEND", InvalidImageDataErr)
+}
+
+func writePNGChunk(out *bytes.Buffer, chunkType string, chunkData []byte) {
+	binary.Write(out, binary.BigEndian, uint32(len(chunkData)))
+	crc := crc32.NewIEEE()
+	crc.Write([]byte(chunkType))
+	crc.Write(chunkData)
+	out.WriteString(chunkType)
+	out.Write(chunkData)
+	binary.Write(out, binary.BigEndian, crc.Sum32())
+}
+
+// sanitizeWebP drops the XMP chunk, the EXIF chunk only keeps the orientation, the VP8X flags and the RIFF size are updated
+func sanitizeWebP(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, fmt.Errorf("%w: missing webp header", InvalidImageDataErr)
+	}
+
+	riffEnd := 8 + int(binary.LittleEndian.Uint32(data[4:]))
+	if riffEnd > len(data) {
+		return nil, fmt.Errorf("%w: truncated webp", InvalidImageDataErr)
+	}
+
+	chunks := bytes.Buffer{}
+	vp8xOffset := -1
+	hasExif := false
+
+	pos := 12
+	for pos+8 <= riffEnd {
+		fourCC := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + size + size%2
+		if end > riffEnd {
+			return nil, fmt.Errorf("%w: invalid webp chunk size", InvalidImageDataErr)
+		}
+		chunk := data[pos:end]
+		pos = end
+
+		switch fourCC {
+		case "XMP ":
+		case "EXIF":
+			orientation := exifOrientation(bytes.TrimPrefix(chunk[8:8+size], exifHeader))
+			if orientation > 1 {
+				exif := orientationTIFF(orientation)
+				chunks.WriteString("EXIF")
+				binary.Write(&chunks, binary.LittleEndian, uint32(len(exif)))
+				chunks.Write(exif)
+				if len(exif)%2 == 1 {
+					chunks.WriteByte(0)
+				}
+				hasExif = true
+			}
+		case "VP8X":
+			vp8xOffset = chunks.Len()
+			chunks.Write(chunk)
+		default:
+			chunks.Write(chunk)
+		}
+	}
+
+	body := chunks.Bytes()
+	if vp8xOffset >= 0 && vp8xOffset+8 < len(body) {
+		flags := body[vp8xOffset+8] &^ 0x0C //EXIF and XMP flags
+		if hasExif {
+			flags |= 0x08
+		}
+		body[vp8xOffset+8] = flags
+	}
+
+	out := bytes.Buffer{}
+	out.WriteString("RIFF")
+	binary.Write(&out, binary.LittleEndian, uint32(4+len(body)))
+	out.WriteString("WEBP")
+	out.Write(body)
+	return out.Bytes(), nil
+}
+
+// imageOrientation reads the EXIF orientation of a jpeg, png or webp image, 0 if missing
+func imageOrientation(data []byte) uint16 {
+	switch {
+	case len(data) >= 2 && data[0] == 0xFF && data[1] == 0xD8:
+		return jpegOrientation(data)
+	case bytes.HasPrefix(data, pngSignature):
+		return pngOrientation(data)
+	case len(data) >= 12 && string(data[0:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return webpOrientation(data)
+	}
+	return 0
+}
+
+// jpegOrientation reads the APP1 EXIF segment, the segments are all before SOS
+func jpegOrientation(data []byte) uint16 {
+	pos := 2
+	for pos < len(data) {
+		if data[pos] != 0xFF {
+			return 0
+		}
+		for pos < len(data) && data[pos] == 0xFF {
+			pos++
+		}
+		if pos >= len(data) {
+			return 0
+		}
+		marker := data[pos]
+		pos++
+
+		switch {
+		case marker == 0xD9, marker == 0xDA: //EOI, SOS
+			return 0
+		case marker >= 0xD0 && marker <= 0xD7, marker == 0x01: //no length
+			continue
+		}
+
+		if pos+2 > len(data) {
+			return 0
+		}
+		length := int(binary.BigEndian.Uint16(data[pos:]))
+		if length < 2 || pos+length > len(data) {
+			return 0
+		}
+		payload := data[pos+2 : pos+length]
+		pos += length
+
+		if marker == 0xE1 && bytes.HasPrefix(payload, exifHeader) {
+			return exifOrientation(payload[len(exifHeader):])
+		}
+	}
+	return 0
+}
+
+func pngOrientation(data []byte) uint16 {
+	pos := len(pngSignature)
+	for pos+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		chunkType := string(data[pos+4 : pos+8])
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return 0
+		}
+
+		switch chunkType {
+		case "eXIf":
+			return exifOrientation(data[pos+8 : pos+8+length])
+		case "IEND":
+			return 0
+		}
+		pos = end
+	}
+	return 0
+}
+
+func webpOrientation(data []byte) uint16 {
+	riffEnd := 8 + int(binary.LittleEndian.Uint32(data[4:]))
+	if riffEnd > len(data) {
+		riffEnd = len(data)
+	}
+
+	pos := 12
+	for pos+8 <= riffEnd {
+		fourCC := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + size + size%2
+		if pos+8+size > riffEnd {
+			return 0
+		}
+
+		if fourCC == "EXIF" {
+			return exifOrientation(bytes.TrimPrefix(data[pos+8:pos+8+size], exifHeader))
+		}
+		pos = end
+	}
+	return 0
+}
+
+// exifOrientation reads the orientation of the IFD0 of a TIFF structure, 0 if missing
+func exifOrientation(tiff []byte) uint16 {
+	if len(tiff) < 8 {
+		return 0
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	ifdOffset := int(order.Uint32(tiff[4:]))
+	if ifdOffset < 8 || ifdOffset+2 > len(tiff) {
+		return 0
+	}
+
+	entries := int(order.Uint16(tiff[ifdOffset:]))
+	for i := 0; i < entries; i++ {
+		entry := ifdOffset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) == exifOrientationTag {
+			orientation := order.Uint16(tiff[entry+8:])
+			if orientation > 8 {
+				return 0
+			}
+			return orientation
+		}
+	}
+	return 0
+}
+
+// orientationTIFF builds a TIFF structure with only the orientation in IFD0
+func orientationTIFF(orientation uint16) []byte {
+	out := bytes.Buffer{}
+	out.WriteString("MM")
+	binary.Write(&out, binary.BigEndian, uint16(42))
+	binary.Write(&out, binary.BigEndian, uint32(8)) //IFD0 offset
+	binary.Write(&out, binary.BigEndian, uint16(1)) //entries
+	binary.Write(&out, binary.BigEndian, uint16(exifOrientationTag))
+	binary.Write(&out, binary.BigEndian, uint16(3)) //SHORT
+	binary.Write(&out, binary.BigEndian, uint32(1)) //count
+	binary.Write(&out, binary.BigEndian, orientation)
+	binary.Write(&out, binary.BigEndian, uint16(0)) //padding of the value
+	binary.Write(&out, binary.BigEndian, uint32(0)) //next IFD
+	return out.Bytes()
+}
@@ -0,0 +1,339 @@
+package appupload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"image"
+	"image/jpeg"
+	"io"
+	"log"
+	"path"
+	"sol.go/cwm/blobstore"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"strings"
+	"time"
+
+	_ "golang.org/x/image/webp"
+	_ "image/gif"
+	_ "image/png"
+)
+
+const (
+	maxVariantSourceSize   = 50 * 1024 << 10 //50 MB - bigger images are kept without variants
+	maxVariantSourcePixels = 50 * 1000 * 1000
+	variantTimeout         = 2 * time.Minute
+	variantWorkers         = 2 //decoding a photo takes a few hundred MB
+)
+
+type variantSpec struct {
+	Variant    grpcCWMPb.MEDIA_VARIANT
+	MaxSide    int
+	Quality    int
+	BlurRadius int
+}
+
+var variantSpecs = []variantSpec{
+	{Variant: grpcCWMPb.MEDIA_VARIANT_PLACEHOLDER, MaxSide: 32, Quality: 40, BlurRadius: 2},
+	{Variant: grpcCWMPb.MEDIA_VARIANT_PREVIEW, MaxSide: 640, Quality: 80},
+}
+
+var (
+	UnsupportedImageErr = errors.New("Unsupported image")
+
+	variantSlots = make(chan struct{}, variantWorkers)
+)
+
+// GenerateVariantsAsync creates the thumbnails of an image in the background, the S3FileInfo is updated once they are stored
+func GenerateVariantsAsync(s3FileInfo *model.S3FileInfo) {
+	if s3FileInfo.MediaType != cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_IMAGE || len(s3FileInfo.Variants) > 0 {
+		return
+	}
+
+	go func(fileId string, blobKey string) {
+		variantSlots <- struct{}{}
+		defer func() { <-variantSlots }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), variantTimeout)
+		defer cancel()
+
+		err := GenerateVariants(ctx, fileId, blobKey)
+		if err != nil {
+			log.Println("Cannot generate variants", fileId, err)
+		}
+	}(s3FileInfo.FileId, s3FileInfo.FileName)
+}
+
+// GenerateVariants sets the variants of the blob on the S3FileInfo, they are created once per blob
+func GenerateVariants(ctx context.Context, fileId string, blobKey string) error {
+	blobRef, err := dao.GetBlobRefDAO().FindByBlobKey(ctx, blobKey)
+	if err != nil {
+		return err
+	}
+
+	variants := blobRef.Variants
+	if len(variants) == 0 {
+		variants, err = createVariants(ctx, blobKey)
+		if err != nil {
+			return err
+		}
+
+		err = saveVariants(ctx, blobKey, variants)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = dao.GetS3FileInfoDAO().SetVariants(ctx, fileId, variants)
+	return err
+}
+
+// saveVariants records the variants on the BlobRef, they are deleted if the blob was released in the meantime
+func saveVariants(ctx context.Context, blobKey string, variants []model.FileVariant) error {
+	redLock, err := lockBlob(ctx, blobKey)
+	if err != nil {
+		return err
+	}
+	defer redLock.Unlock()
+
+	_, err = dao.GetBlobRefDAO().SetVariants(ctx, blobKey, variants)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			deleteVariants(ctx, variants)
+		}
+		return err
+	}
+	return nil
+}
+
+func deleteVariants(ctx context.Context, variants []model.FileVariant) {
+	for _, variant := range variants {
+		err := blobstore.GetBlobStore().Delete(ctx, variant.FileName)
+		if err != nil {
+			log.Println("Cannot delete variant", variant.FileName, err)
+		}
+	}
+}
+
+func createVariants(ctx context.Context, blobKey string) ([]model.FileVariant, error) {
+	blobStore := blobstore.GetBlobStore()
+
+	body, blobInfo, err := blobStore.Get(ctx, blobKey)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	if blobInfo.Size > maxVariantSourceSize {
+		return nil, fmt.Errorf("%w: %d bytes", UnsupportedImageErr, blobInfo.Size)
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read image: %w", err)
+	}
+
+	img, err := decodeImage(data)
+	if err != nil {
+		return nil, err
+	}
+	//the original keeps its EXIF orientation, the variants have no metadata so they are stored upright
+	orientation := imageOrientation(data)
+
+	variants := []model.FileVariant{}
+	for _, spec := range variantSpecs {
+		//resizing fits both sides in MaxSide, orienting the small image gives the same result for less memory
+		variantImg := orientImage(resizeImage(img, spec.MaxSide), orientation)
+		if spec.BlurRadius > 0 {
+			blurImage(variantImg, spec.BlurRadius)
+		}
+
+		buffer := bytes.Buffer{}
+		err = jpeg.Encode(&buffer, variantImg, &jpeg.Options{Quality: spec.Quality})
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode variant: %w", err)
+		}
+
+		variant := model.FileVariant{
+			Variant:  spec.Variant,
+			FileName: variantBlobKey(blobKey, spec.Variant),
+			FileSize: int64(buffer.Len()),
+			Width:    int32(variantImg.Bounds().Dx()),
+			Height:   int32(variantImg.Bounds().Dy()),
+		}
+		_, err = blobStore.Put(ctx, variant.FileName, &buffer, "image/jpeg")
+		if err != nil {
+			deleteVariants(ctx, variants)
+			return nil, fmt.Errorf("cannot save variant: %w", err)
+		}
+		variants = append(variants, variant)
+	}
+
+	return variants, nil
+}
+
+// decodeImage checks the dimensions before decoding, a small file can declare a huge image
+func decodeImage(data []byte) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", UnsupportedImageErr, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxVariantSourcePixels {
+		return nil, fmt.Errorf("%w: %dx%d", UnsupportedImageErr, config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", UnsupportedImageErr, err)
+	}
+	return img, nil
+}
+
+// variantBlobKey - the variants are named from the original blob, so they are shared like the blob
+func variantBlobKey(blobKey string, variant grpcCWMPb.MEDIA_VARIANT) string {
+	return fmt.Sprintf("%s_%s.jpg", strings.TrimSuffix(blobKey, path.Ext(blobKey)), strings.ToLower(variant.String()))
+}
+
+// resizeImage scales the image down to fit maxSide, each pixel is the average of the source pixels it covers.
+// The transparent pixels are drawn over white, jpeg has no alpha
+func resizeImage(src image.Image, maxSide int) *image.RGBA {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	dstWidth, dstHeight := width, height
+	if width > maxSide || height > maxSide {
+		if width >= height {
+			dstWidth = maxSide
+			dstHeight = height * maxSide / width
+		} else {
+			dstHeight = maxSide
+			dstWidth = width * maxSide / height
+		}
+	}
+	if dstWidth < 1 {
+		dstWidth = 1
+	}
+	if dstHeight < 1 {
+		dstHeight = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		srcY0 := bounds.Min.Y + y*height/dstHeight
+		srcY1 := bounds.Min.Y + (y+1)*height/dstHeight
+		if srcY1 <= srcY0 {
+			srcY1 = srcY0 + 1
+		}
+
+		for x := 0; x < dstWidth; x++ {
+			srcX0 := bounds.Min.X + x*width/dstWidth
+			srcX1 := bounds.Min.X + (x+1)*width/dstWidth
+			if srcX1 <= srcX0 {
+				srcX1 = srcX0 + 1
+			}
+
+			var r, g, b, a, n uint64
+			for srcY := srcY0; srcY < srcY1; srcY++ {
+				for srcX := srcX0; srcX < srcX1; srcX++ {
+					cr, cg, cb, ca := src.At(srcX, srcY).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					n++
+				}
+			}
+
+			//premultiplied colors over white
+			white := 0xffff - a/n
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8((r/n + white) >> 8)
+			dst.Pix[offset+1] = uint8((g/n + white) >> 8)
+			dst.Pix[offset+2] = uint8((b/n + white) >> 8)
+			dst.Pix[offset+3] = 0xff
+		}
+	}
+	return dst
+}
+
+// orientImage rotates and flips the image as the EXIF orientation (2-8) tells the viewers to
+func orientImage(src *image.RGBA, orientation uint16) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 { //the sides are swapped
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		for x := 0; x < dstWidth; x++ {
+			var srcX, srcY int
+			switch orientation {
+			case 2: //mirrored
+				srcX, srcY = width-1-x, y
+			case 3: //rotated 180
+				srcX, srcY = width-1-x, height-1-y
+			case 4: //flipped
+				srcX, srcY = x, height-1-y
+			case 5: //transposed
+				srcX, srcY = y, x
+			case 6: //rotated 90 CW
+				srcX, srcY = y, height-1-x
+			case 7: //transversed
+				srcX, srcY = width-1-y, height-1-x
+			case 8: //rotated 90 CCW
+				srcX, srcY = width-1-y, x
+			}
+
+			srcOffset := src.PixOffset(src.Bounds().Min.X+srcX, src.Bounds().Min.Y+srcY)
+			dstOffset := dst.PixOffset(x, y)
+			copy(dst.Pix[dstOffset:dstOffset+4], src.Pix[srcOffset:srcOffset+4])
+		}
+	}
+	return dst
+}
+
+// blurImage applies a box blur of radius, horizontally then vertically
+func blurImage(img *image.RGBA, radius int) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	tmp := make([]uint8, len(img.Pix))
+
+	blurPass(img.Pix, tmp, width, height, radius, 4, img.Stride)
+	blurPass(tmp, img.Pix, height, width, radius, img.Stride, 4)
+}
+
+// blurPass averages the pixels along the lines of length, step is the offset between the pixels of a line, lineStep between the lines
+func blurPass(src []uint8, dst []uint8, length int, lines int, radius int, step int, lineStep int) {
+	for line := 0; line < lines; line++ {
+		base := line * lineStep
+		for i := 0; i < length; i++ {
+			var sum [4]int
+			n := 0
+			for k := i - radius; k <= i+radius; k++ {
+				if k < 0 || k >= length {
+					continue
+				}
+				offset := base + k*step
+				for c := 0; c < 4; c++ {
+					sum[c] += int(src[offset+c])
+				}
+				n++
+			}
+
+			offset := base + i*step
+			for c := 0; c < 4; c++ {
+				dst[offset+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+}
@@ -0,0 +1,363 @@
+package appupload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func jpegWithOrientation(orientation uint16) []byte {
+	exif := append(append([]byte{}, exifHeader...), orientationTIFF(orientation)...)
+
+	out := bytes.Buffer{}
+	out.Write([]byte{0xFF, 0xD8})
+	out.Write([]byte{0xFF, 0xE0, 0x00, 0x04, 0x00, 0x00}) //APP0 before the EXIF
+	out.Write([]byte{0xFF, 0xE1})
+	binary.Write(&out, binary.BigEndian, uint16(len(exif)+2))
+	out.Write(exif)
+	out.Write([]byte{0xFF, 0xD9})
+	return out.Bytes()
+}
+
+func pngWithOrientation(orientation uint16) []byte {
+	out := bytes.Buffer{}
+	out.Write(pngSignature)
+	writePNGChunk(&out, "IHDR", make([]byte, 13))
+	writePNGChunk(&out, "eXIf", orientationTIFF(orientation))
+	writePNGChunk(&out, "IEND", nil)
+	return out.Bytes()
+}
+
+func webpWithOrientation(orientation uint16) []byte {
+	exif := orientationTIFF(orientation)
+
+	body := bytes.Buffer{}
+	body.WriteString("VP8X")
+	binary.Write(&body, binary.LittleEndian, uint32(10))
+	body.Write(make([]byte, 10))
+	body.WriteString("EXIF")
+	binary.Write(&body, binary.LittleEndian, uint32(len(exif)))
+	body.Write(exif)
+
+	out := bytes.Buffer{}
+	out.WriteString("RIFF")
+	binary.Write(&out, binary.LittleEndian, uint32(4+body.Len()))
+	out.WriteString("WEBP")
+	out.Write(body.Bytes())
+	return out.Bytes()
+}
+
+func TestImageOrientation(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want uint16
+	}{
+		{"jpeg", jpegWithOrientation(6), 6},
+		{"png", pngWithOrientation(3), 3},
+		{"webp", webpWithOrientation(8), 8},
+		{"jpeg without exif", []byte{0xFF, 0xD8, 0xFF, 0xD9}, 0},
+		{"truncated jpeg", jpegWithOrientation(6)[:12], 0},
+		{"gif", []byte("GIF89a"), 0},
+	}
+
+	for _, test := range tests {
+		if got := imageOrientation(test.data); got != test.want {
+			t.Errorf("%v: orientation = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// testImage is 3x2, each pixel has its own red value: 10*x + y
+func testImage() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(10*x + y), A: 0xff})
+		}
+	}
+	return img
+}
+
+func TestOrientImage(t *testing.T) {
+	//the red values of the rows once the image is upright
+	tests := []struct {
+		orientation uint16
+		want        [][]uint8
+	}{
+		{1, [][]uint8{{0, 10, 20}, {1, 11, 21}}},
+		{2, [][]uint8{{20, 10, 0}, {21, 11, 1}}},
+		{3, [][]uint8{{21, 11, 1}, {20, 10, 0}}},
+		{4, [][]uint8{{1, 11, 21}, {0, 10, 20}}},
+		{5, [][]uint8{{0, 1}, {10, 11}, {20, 21}}},
+		{6, [][]uint8{{1, 0}, {11, 10}, {21, 20}}},
+		{7, [][]uint8{{21, 20}, {11, 10}, {1, 0}}},
+		{8, [][]uint8{{20, 21}, {10, 11}, {0, 1}}},
+	}
+
+	for _, test := range tests {
+		img := orientImage(testImage(), test.orientation)
+		if img.Bounds().Dy() != len(test.want) || img.Bounds().Dx() != len(test.want[0]) {
+			t.Errorf("orientation %v: size = %v", test.orientation, img.Bounds())
+			continue
+		}
+
+		for y, row := range test.want {
+			for x, want := range row {
+				if got := img.RGBAAt(x, y).R; got != want {
+					t.Errorf("orientation %v: pixel %v,%v = %v, want %v", test.orientation, x, y, got, want)
+				}
+			}
+		}
+	}
+}
+
+// exifWithGPS is a little endian TIFF with the orientation, the camera make and a GPS IFD
+func exifWithGPS(orientation uint16) []byte {
+	out := bytes.Buffer{}
+	entry := func(tag uint16, fieldType uint16, count uint32, value uint32) {
+		binary.Write(&out, binary.LittleEndian, tag)
+		binary.Write(&out, binary.LittleEndian, fieldType)
+		binary.Write(&out, binary.LittleEndian, count)
+		binary.Write(&out, binary.LittleEndian, value)
+	}
+
+	out.WriteString("II")
+	binary.Write(&out, binary.LittleEndian, uint16(42))
+	binary.Write(&out, binary.LittleEndian, uint32(8))
+	//IFD0 at 8, 3 entries until 50
+	binary.Write(&out, binary.LittleEndian, uint16(3))
+	entry(0x010F, 2, 10, 50) //Make
+	entry(exifOrientationTag, 3, 1, uint32(orientation))
+	entry(0x8825, 4, 1, 60) //GPS IFD
+	binary.Write(&out, binary.LittleEndian, uint32(0))
+	out.WriteString("secretcam\x00")
+	//GPS IFD at 60
+	binary.Write(&out, binary.LittleEndian, uint16(1))
+	entry(0x0001, 2, 2, uint32('N')) //GPSLatitudeRef
+	binary.Write(&out, binary.LittleEndian, uint32(0))
+	return out.Bytes()
+}
+
+func jpegSegment(marker byte, payload []byte) []byte {
+	out := bytes.Buffer{}
+	out.Write([]byte{0xFF, marker})
+	binary.Write(&out, binary.BigEndian, uint16(len(payload)+2))
+	out.Write(payload)
+	return out.Bytes()
+}
+
+func encodedJPEG(t *testing.T) []byte {
+	out := bytes.Buffer{}
+	err := jpeg.Encode(&out, testImage(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out.Bytes()
+}
+
+func encodedPNG(t *testing.T) []byte {
+	out := bytes.Buffer{}
+	err := png.Encode(&out, testImage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out.Bytes()
+}
+
+func join(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+func TestSanitizeJPEG(t *testing.T) {
+	encoded := encodedJPEG(t)
+	soi, frame := encoded[:2], encoded[2:]
+
+	gpsExif := jpegSegment(0xE1, join(exifHeader, exifWithGPS(6)))
+	xmp := jpegSegment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta>secret</x:xmpmeta>"))
+	iptc := jpegSegment(0xED, []byte("Photoshop 3.0\x008BIM\x04\x04secret"))
+	comment := jpegSegment(0xFE, []byte("secret"))
+	mpf := jpegSegment(0xE2, []byte("MPF\x00secret"))
+	app0 := jpegSegment(0xE0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00"))
+	orientation := jpegSegment(0xE1, join(exifHeader, orientationTIFF(6)))
+
+	tests := []struct {
+		name string
+		data []byte
+		want []byte
+	}{
+		{"gps exif", join(soi, gpsExif, frame), join(soi, orientation, frame)},
+		{"exif without rotation", join(soi, jpegSegment(0xE1, join(exifHeader, exifWithGPS(1))), frame), encoded},
+		{"xmp iptc comment", join(soi, app0, xmp, iptc, comment, frame), join(soi, app0, frame)},
+		{"mpf and secondary image", join(soi, mpf, gpsExif, frame, encoded), join(soi, orientation, frame)},
+		{"without metadata", encoded, encoded},
+	}
+
+	for _, test := range tests {
+		got, err := sanitizeJPEG(test.data)
+		if err != nil {
+			t.Errorf("%v: error %v", test.name, err)
+			continue
+		}
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("%v: sanitized jpeg differs, %v bytes, want %v", test.name, len(got), len(test.want))
+		}
+		if bytes.Contains(got, []byte("secret")) {
+			t.Errorf("%v: metadata left in the sanitized jpeg", test.name)
+		}
+		if _, err = jpeg.Decode(bytes.NewReader(got)); err != nil {
+			t.Errorf("%v: cannot decode the sanitized jpeg %v", test.name, err)
+		}
+	}
+
+	_, err := sanitizeJPEG([]byte("GIF89a"))
+	if err == nil {
+		t.Error("not a jpeg: no error")
+	}
+}
+
+// pngChunks splits a png after its signature
+func pngChunks(data []byte) [][]byte {
+	chunks := [][]byte{}
+	for pos := len(pngSignature); pos+12 <= len(data); {
+		end := pos + 12 + int(binary.BigEndian.Uint32(data[pos:]))
+		chunks = append(chunks, data[pos:end])
+		pos = end
+	}
+	return chunks
+}
+
+func pngChunk(chunkType string, chunkData []byte) []byte {
+	out := bytes.Buffer{}
+	writePNGChunk(&out, chunkType, chunkData)
+	return out.Bytes()
+}
+
+func TestSanitizePNG(t *testing.T) {
+	chunks := pngChunks(encodedPNG(t))
+	ihdr, rest := chunks[0], join(chunks[1:]...)
+
+	text := pngChunk("tEXt", []byte("Comment\x00secret"))
+	itxt := pngChunk("iTXt", []byte("XML:com.adobe.xmp\x00\x00\x00\x00\x00<x:xmpmeta>secret</x:xmpmeta>"))
+	ztxt := pngChunk("zTXt", []byte("Author\x00\x00secret"))
+	tIME := pngChunk("tIME", []byte{0x07, 0xE6, 1, 2, 3, 4, 5})
+	gpsExif := pngChunk("eXIf", exifWithGPS(3))
+	orientation := pngChunk("eXIf", orientationTIFF(3))
+
+	tests := []struct {
+		name string
+		data []byte
+		want []byte
+	}{
+		{"gps exif", join(pngSignature, ihdr, gpsExif, rest), join(pngSignature, ihdr, orientation, rest)},
+		{"exif without rotation", join(pngSignature, ihdr, pngChunk("eXIf", exifWithGPS(1)), rest), join(pngSignature, ihdr, rest)},
+		{"text and time", join(pngSignature, ihdr, text, itxt, ztxt, tIME, rest), join(pngSignature, ihdr, rest)},
+		{"without metadata", join(pngSignature, ihdr, rest), join(pngSignature, ihdr, rest)},
+	}
+
+	for _, test := range tests {
+		got, err := sanitizePNG(test.data)
+		if err != nil {
+			t.Errorf("%v: error %v", test.name, err)
+			continue
+		}
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("%v: sanitized png differs, %v bytes, want %v", test.name, len(got), len(test.want))
+		}
+		if bytes.Contains(got, []byte("secret")) {
+			t.Errorf("%v: metadata left in the sanitized png", test.name)
+		}
+
+		for _, chunk := range pngChunks(got) {
+			crc := binary.BigEndian.Uint32(chunk[len(chunk)-4:])
+			if want := crc32.ChecksumIEEE(chunk[4 : len(chunk)-4]); crc != want {
+				t.Errorf("%v: %s chunk crc = %x, want %x", test.name, chunk[4:8], crc, want)
+			}
+		}
+		if _, err = png.Decode(bytes.NewReader(got)); err != nil {
+			t.Errorf("%v: cannot decode the sanitized png %v", test.name, err)
+		}
+	}
+
+	_, err := sanitizePNG(join(pngSignature, ihdr))
+	if err == nil {
+		t.Error("png without IEND: no error")
+	}
+}
+
+func webpChunk(fourCC string, chunkData []byte) []byte {
+	out := bytes.Buffer{}
+	out.WriteString(fourCC)
+	binary.Write(&out, binary.LittleEndian, uint32(len(chunkData)))
+	out.Write(chunkData)
+	if len(chunkData)%2 == 1 {
+		out.WriteByte(0)
+	}
+	return out.Bytes()
+}
+
+func webpFile(chunks ...[]byte) []byte {
+	body := join(chunks...)
+	out := bytes.Buffer{}
+	out.WriteString("RIFF")
+	binary.Write(&out, binary.LittleEndian, uint32(4+len(body)))
+	out.WriteString("WEBP")
+	out.Write(body)
+	return out.Bytes()
+}
+
+// vp8x has the ICC flag with the given EXIF / XMP flags, for a 3x2 canvas
+func vp8x(flags byte) []byte {
+	return webpChunk("VP8X", []byte{0x20 | flags, 0, 0, 0, 2, 0, 0, 1, 0, 0})
+}
+
+func TestSanitizeWebP(t *testing.T) {
+	const exifFlag, xmpFlag = 0x08, 0x04
+
+	iccp := webpChunk("ICCP", []byte("icc"))
+	bitstream := webpChunk("VP8L", []byte{0x2F, 0x02, 0x40, 0x00, 0x00}) //odd size, padded
+	gpsExif := webpChunk("EXIF", join(exifHeader, exifWithGPS(8)))
+	xmp := webpChunk("XMP ", []byte("<x:xmpmeta>secret</x:xmpmeta>"))
+	orientation := webpChunk("EXIF", orientationTIFF(8))
+
+	tests := []struct {
+		name string
+		data []byte
+		want []byte
+	}{
+		{"gps exif and xmp", webpFile(vp8x(exifFlag|xmpFlag), iccp, bitstream, gpsExif, xmp), webpFile(vp8x(exifFlag), iccp, bitstream, orientation)},
+		{"exif without rotation", webpFile(vp8x(exifFlag), iccp, bitstream, webpChunk("EXIF", exifWithGPS(1))), webpFile(vp8x(0), iccp, bitstream)},
+		{"xmp", webpFile(vp8x(xmpFlag), iccp, bitstream, xmp), webpFile(vp8x(0), iccp, bitstream)},
+		{"simple format", webpFile(bitstream), webpFile(bitstream)},
+	}
+
+	for _, test := range tests {
+		got, err := sanitizeWebP(test.data)
+		if err != nil {
+			t.Errorf("%v: error %v", test.name, err)
+			continue
+		}
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("%v: sanitized webp differs, %v bytes, want %v", test.name, len(got), len(test.want))
+		}
+		if bytes.Contains(got, []byte("secret")) {
+			t.Errorf("%v: metadata left in the sanitized webp", test.name)
+		}
+		if size := binary.LittleEndian.Uint32(got[4:]); int(size) != len(got)-8 {
+			t.Errorf("%v: riff size = %v, want %v", test.name, size, len(got)-8)
+		}
+		if string(got[12:16]) == "VP8X" && got[20] != test.want[20] {
+			t.Errorf("%v: vp8x flags = %x, want %x", test.name, got[20], test.want[20])
+		}
+	}
+
+	_, err := sanitizeWebP(webpFile(vp8x(0), []byte("VP8L\xFF\x00\x00\x00")))
+	if err == nil {
+		t.Error("chunk past the riff size: no error")
+	}
+}
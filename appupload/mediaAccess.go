@@ -0,0 +1,97 @@
+package appupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/static"
+	"strings"
+)
+
+var (
+	InvalidMediaMsgErr   = errors.New("Invalid media msg")
+	MediaAccessDeniedErr = errors.New("Media access denied")
+)
+
+// BindMediaMsg checks the uploader can attach a media to the msg before the upload:
+// the uploader is a participant of the thread and the msg, if already sent, was sent by the uploader in this thread.
+// The solo threads are created by their first msg, so the thread may not exist yet
+func BindMediaMsg(ctx context.Context, phoneFull string, msgId string, threadId string) error {
+	if len(msgId) == 0 || len(threadId) == 0 {
+		return fmt.Errorf("%w: missing msgId or threadId", InvalidMediaMsgErr)
+	}
+
+	signalThread, err := dao.GetSignalThreadDAO().FindByThreadId(ctx, threadId)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	if signalThread != nil && !slices.Contains(signalThread.Participants, phoneFull) {
+		return fmt.Errorf("%w: not a participant of thread %s", InvalidMediaMsgErr, threadId)
+	}
+
+	signalMsg, err := dao.GetSignalMsgDAO().FindByMsgId(ctx, msgId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		return err
+	}
+	if signalMsg.From != phoneFull || signalMsg.ThreadId != threadId {
+		return fmt.Errorf("%w: msg %s was not sent by the uploader in thread %s", InvalidMediaMsgErr, msgId, threadId)
+	}
+	return nil
+}
+
+// AuthorizeMediaAccess checks the user can download the media: a participant of the thread of the msg, or a former participant
+// for the msgs sent before the removal, which did not delete the msg. The media of a msg not sent yet is only available to its uploader.
+// The avatars are available to any user
+func AuthorizeMediaAccess(ctx context.Context, s3FileInfo *model.S3FileInfo, phoneFull string) error {
+	if strings.HasPrefix(s3FileInfo.MsgId, static.AvatarMsgPrefix) {
+		return nil
+	}
+
+	signalMsg, err := dao.GetSignalMsgDAO().FindByMsgId(ctx, s3FileInfo.MsgId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			if len(s3FileInfo.Uploader) > 0 && s3FileInfo.Uploader == phoneFull {
+				return nil
+			}
+			return fmt.Errorf("%w: msg %s not sent", MediaAccessDeniedErr, s3FileInfo.MsgId)
+		}
+		return err
+	}
+
+	//the files uploaded before the uploads were bound to the msgs have no uploader
+	if len(s3FileInfo.Uploader) > 0 && s3FileInfo.Uploader != signalMsg.From {
+		return fmt.Errorf("%w: msg %s was not sent by the uploader", MediaAccessDeniedErr, s3FileInfo.MsgId)
+	}
+	if len(s3FileInfo.ThreadId) > 0 && s3FileInfo.ThreadId != signalMsg.ThreadId {
+		return fmt.Errorf("%w: msg %s is not in thread %s", MediaAccessDeniedErr, s3FileInfo.MsgId, s3FileInfo.ThreadId)
+	}
+
+	if slices.Contains(signalMsg.DeleteForUsers, phoneFull) {
+		return fmt.Errorf("%w: msg %s deleted", MediaAccessDeniedErr, s3FileInfo.MsgId)
+	}
+
+	signalThread, err := dao.GetSignalThreadDAO().FindByThreadId(ctx, signalMsg.ThreadId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("%w: thread %s not found", MediaAccessDeniedErr, signalMsg.ThreadId)
+		}
+		return err
+	}
+
+	if slices.Contains(signalThread.Participants, phoneFull) {
+		return nil
+	}
+
+	removedAt := signalThread.RemovedAt(phoneFull)
+	if removedAt > 0 && signalMsg.CreatedAt <= removedAt {
+		return nil
+	}
+	return fmt.Errorf("%w: not a participant of thread %s", MediaAccessDeniedErr, signalMsg.ThreadId)
+}
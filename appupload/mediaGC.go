@@ -0,0 +1,270 @@
+package appupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+	"log"
+	"os"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/static"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultGCInterval  = 6 * 60 * 60 //seconds
+	defaultGCOrphanAge = 24          //hours
+
+	gcBatchSize = 100
+	gcLockName  = "s3FileInfo_gc"
+)
+
+var (
+	MediaGCBusyErr = errors.New("Media GC is running on another node")
+)
+
+// MediaGCReport - in dry run nothing is deleted, ReclaimedBytes is an estimate of what would be reclaimed
+type MediaGCReport struct {
+	DryRun         bool  `json:"dryRun"`
+	ScannedFiles   int64 `json:"scannedFiles"`
+	UnsentFiles    int64 `json:"unsentFiles"`  //the msg was never sent
+	DeletedFiles   int64 `json:"deletedFiles"` //the msg is deleted for every participant
+	ReleasedFiles  int64 `json:"releasedFiles"`
+	ReclaimedBytes int64 `json:"reclaimedBytes"`
+	StartedAt      int64 `json:"startedAt"`
+	Duration       int64 `json:"duration"` //millis
+}
+
+// MediaGC releases the files which can not be downloaded anymore: the files of the msgs deleted for every participant
+// and the files uploaded for a msg which is still not sent after OrphanAge. The blobs are deleted once no file refers to them
+type MediaGC struct {
+	Interval  time.Duration
+	OrphanAge time.Duration
+	DryRun    bool
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	done     chan struct{}
+}
+
+var (
+	singletonMediaGC *MediaGC
+	onceMediaGC      sync.Once
+)
+
+// GetMediaGC - MEDIA_GC_INTERVAL (seconds, default 6 hours), MEDIA_GC_ORPHAN_AGE (hours an upload waits for its msg, default 24)
+// and MEDIA_GC_DRY_RUN (only report what would be released)
+func GetMediaGC() *MediaGC {
+	onceMediaGC.Do(func() {
+		fmt.Println("Init MediaGC...")
+
+		interval, err := strconv.ParseInt(os.Getenv("MEDIA_GC_INTERVAL"), 10, 64)
+		if err != nil || interval <= 0 {
+			interval = defaultGCInterval
+		}
+
+		orphanAge, err := strconv.ParseInt(os.Getenv("MEDIA_GC_ORPHAN_AGE"), 10, 64)
+		if err != nil || orphanAge <= 0 {
+			orphanAge = defaultGCOrphanAge
+		}
+
+		dryRun, _ := strconv.ParseBool(os.Getenv("MEDIA_GC_DRY_RUN"))
+
+		singletonMediaGC = &MediaGC{
+			Interval:  time.Duration(interval) * time.Second,
+			OrphanAge: time.Duration(orphanAge) * time.Hour,
+			DryRun:    dryRun,
+			stopCh:    make(chan struct{}),
+			done:      make(chan struct{}),
+		}
+	})
+	return singletonMediaGC
+}
+
+func (gc *MediaGC) Start() {
+	go gc.run()
+}
+
+func (gc *MediaGC) Stop(ctx context.Context) error {
+	gc.stopOnce.Do(func() {
+		close(gc.stopCh)
+	})
+
+	select {
+	case <-gc.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("media gc job is not stopped: %w", ctx.Err())
+	}
+}
+
+func (gc *MediaGC) run() {
+	defer close(gc.done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-gc.stopCh
+		cancel()
+	}()
+
+	ticker := time.NewTicker(gc.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-gc.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		report, err := gc.Run(ctx, gc.DryRun)
+		if err != nil {
+			if !errors.Is(err, MediaGCBusyErr) {
+				log.Println("MediaGC - error", err)
+			}
+			continue
+		}
+		log.Printf("MediaGC - dryRun: %v, scanned %v files, released %v files (unsent: %v, deleted: %v), reclaimed %v bytes in %v ms\n",
+			report.DryRun, report.ScannedFiles, report.ReleasedFiles, report.UnsentFiles, report.DeletedFiles, report.ReclaimedBytes, report.Duration)
+	}
+}
+
+// Run scans all the files once, only one node runs it at a time
+func (gc *MediaGC) Run(ctx context.Context, dryRun bool) (*MediaGCReport, error) {
+	s3FileInfoDAO := dao.GetS3FileInfoDAO()
+	redLock := s3FileInfoDAO.CreateRedlockNoRetry(ctx, gcLockName, gc.Interval)
+	err := redLock.Lock()
+	if err != nil {
+		return nil, MediaGCBusyErr
+	}
+	defer redLock.Unlock()
+
+	startedAt := time.Now()
+	report := &MediaGCReport{
+		DryRun:    dryRun,
+		StartedAt: startedAt.UnixMilli(),
+	}
+	orphanBefore := startedAt.Add(-gc.OrphanAge).UnixMilli()
+	threads := map[string]*model.SignalThread{}
+
+	lastId := primitive.NilObjectID
+	for {
+		s3FileInfos, err := s3FileInfoDAO.FindAllAfter(ctx, lastId, gcBatchSize)
+		if err != nil {
+			return report, err
+		}
+
+		for _, s3FileInfo := range s3FileInfos {
+			report.ScannedFiles++
+
+			unsent, deleted, err := gc.isGarbage(ctx, s3FileInfo, orphanBefore, threads)
+			if err != nil {
+				log.Println("MediaGC - cannot check file", s3FileInfo.FileId, err)
+				continue
+			}
+			if !unsent && !deleted {
+				continue
+			}
+
+			reclaimed, err := gc.release(ctx, s3FileInfo, dryRun)
+			if err != nil {
+				log.Println("MediaGC - cannot release file", s3FileInfo.FileId, err)
+				continue
+			}
+
+			if unsent {
+				report.UnsentFiles++
+			} else {
+				report.DeletedFiles++
+			}
+			report.ReleasedFiles++
+			report.ReclaimedBytes += reclaimed
+		}
+
+		//a long pass keeps the lock, another node does not run at the same time
+		_, err = redLock.ExtendContext(ctx)
+		if err != nil {
+			return report, fmt.Errorf("media gc lost its lock: %w", err)
+		}
+
+		if len(s3FileInfos) < gcBatchSize {
+			break
+		}
+		lastId = s3FileInfos[len(s3FileInfos)-1].ID
+	}
+
+	report.Duration = time.Since(startedAt).Milliseconds()
+	return report, nil
+}
+
+// isGarbage - unsent: no msg after orphanBefore, deleted: the msg is deleted for every participant of its thread or the thread is deleted.
+// The avatars are released when they are replaced
+func (gc *MediaGC) isGarbage(ctx context.Context, s3FileInfo *model.S3FileInfo, orphanBefore int64, threads map[string]*model.SignalThread) (bool, bool, error) {
+	if strings.HasPrefix(s3FileInfo.MsgId, static.AvatarMsgPrefix) {
+		return false, false, nil
+	}
+
+	signalMsg, err := dao.GetSignalMsgDAO().FindByMsgId(ctx, s3FileInfo.MsgId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return s3FileInfo.CreatedAt < orphanBefore, false, nil
+		}
+		return false, false, err
+	}
+
+	signalThread, ok := threads[signalMsg.ThreadId]
+	if !ok {
+		signalThread, err = dao.GetSignalThreadDAO().FindByThreadId(ctx, signalMsg.ThreadId)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return false, false, err
+		}
+		threads[signalMsg.ThreadId] = signalThread
+	}
+	if signalThread == nil {
+		return false, true, nil
+	}
+	if len(signalThread.AllParticipants) == 0 {
+		return false, false, nil
+	}
+
+	for _, participant := range signalThread.AllParticipants {
+		if !slices.Contains(signalMsg.DeleteForUsers, participant) {
+			return false, false, nil
+		}
+	}
+	return false, true, nil
+}
+
+func (gc *MediaGC) release(ctx context.Context, s3FileInfo *model.S3FileInfo, dryRun bool) (int64, error) {
+	if !dryRun {
+		return ReleaseFile(ctx, s3FileInfo.FileId)
+	}
+
+	//the blob would be deleted with its last reference
+	blobRef, err := dao.GetBlobRefDAO().FindByBlobKey(ctx, s3FileInfo.FileName)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, err
+	}
+	if blobRef != nil && blobRef.RefCount > 1 {
+		return 0, nil
+	}
+
+	count, err := dao.GetS3FileInfoDAO().CountByFileName(ctx, s3FileInfo.FileName)
+	if err != nil || count > 1 {
+		return 0, err
+	}
+
+	reclaimed := s3FileInfo.FileSize
+	for _, variant := range s3FileInfo.Variants {
+		reclaimed += variant.FileSize
+	}
+	return reclaimed, nil
+}
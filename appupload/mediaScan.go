@@ -0,0 +1,220 @@
+package appupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"log"
+	"sol.go/cwm/blobstore"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/scanner"
+	"sol.go/cwm/static"
+	"time"
+)
+
+const (
+	scanTimeout    = 5 * time.Minute
+	scanWorkers    = 2
+	scanAttempts   = 3
+	scanRetryDelay = 30 * time.Second
+)
+
+var (
+	MediaBlockedErr     = errors.New("Media blocked by the content scan")
+	MediaScanPendingErr = errors.New("Media is being scanned")
+
+	scanSlots = make(chan struct{}, scanWorkers)
+)
+
+// ScanRequired - the docs and the other files are scanned, the images, videos and audios are only checked by their type
+func ScanRequired(mediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE) bool {
+	return mediaType == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_DOC || mediaType == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_FILE
+}
+
+// initialScanStatus - a file is pending until its blob is scanned, once per blob
+func initialScanStatus(mediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE, blobRef *model.BlobRef) string {
+	if !ScanRequired(mediaType) {
+		return ""
+	}
+	if blobRef.ScanStatus == model.SCAN_STATUS_CLEAN {
+		return model.SCAN_STATUS_CLEAN
+	}
+	return model.SCAN_STATUS_PENDING
+}
+
+// CheckMediaScan refuses the files blocked by the scan or not scanned yet
+func CheckMediaScan(s3FileInfo *model.S3FileInfo) error {
+	switch s3FileInfo.ScanStatus {
+	case model.SCAN_STATUS_BLOCKED:
+		return fmt.Errorf("%w: %s", MediaBlockedErr, s3FileInfo.BlockedReason)
+	case model.SCAN_STATUS_PENDING:
+		return MediaScanPendingErr
+	}
+	return nil
+}
+
+// ScanFileAsync scans the blob of a pending file in the background, retried while the scanner is unavailable.
+// The file stays pending when all the attempts fail, MediaScanSweep scans it later
+func ScanFileAsync(s3FileInfo *model.S3FileInfo) {
+	if s3FileInfo.ScanStatus != model.SCAN_STATUS_PENDING {
+		return
+	}
+
+	go func(fileId string, blobKey string) {
+		for attempt := 1; ; attempt++ {
+			scanSlots <- struct{}{}
+			ctx, cancel := context.WithTimeout(context.Background(), scanTimeout)
+			err := ScanFile(ctx, blobKey)
+			cancel()
+			<-scanSlots
+
+			if err == nil {
+				return
+			}
+			log.Println("Cannot scan file", fileId, attempt, err)
+			if attempt >= scanAttempts {
+				return
+			}
+			time.Sleep(time.Duration(attempt) * scanRetryDelay)
+		}
+	}(s3FileInfo.FileId, s3FileInfo.FileName)
+}
+
+// ScanFile scans the blob once and sets the result on all its S3FileInfos.
+// An infected file, or a file the scanner refuses by its policy (size, archive depth), is quarantined
+func ScanFile(ctx context.Context, blobKey string) error {
+	scan := &mediaScan{
+		store:     daoScanStore{},
+		blobStore: blobstore.GetBlobStore(),
+		scanner:   scanner.GetScanner(),
+	}
+	return scan.scanFile(ctx, blobKey)
+}
+
+// scanStore reads the BlobRef of a scanned blob and records the scan results on the BlobRef and on the S3FileInfos of the blob
+type scanStore interface {
+	FindBlobRef(ctx context.Context, blobKey string) (*model.BlobRef, error)
+	SetBlobScanResult(ctx context.Context, blobKey string, scanStatus string, blockedReason string, quarantineKey string) error
+	SetFilesScanResult(ctx context.Context, blobKey string, scanStatus string, blockedReason string) error
+	// LockBlob - the references of a blob do not change while it is quarantined
+	LockBlob(ctx context.Context, blobKey string) (blobLock, error)
+}
+
+type blobLock interface {
+	Unlock() (bool, error)
+}
+
+// daoScanStore records the scan results with BlobRefDAO and S3FileInfoDAO
+type daoScanStore struct{}
+
+func (daoScanStore) FindBlobRef(ctx context.Context, blobKey string) (*model.BlobRef, error) {
+	return dao.GetBlobRefDAO().FindByBlobKey(ctx, blobKey)
+}
+
+func (daoScanStore) SetBlobScanResult(ctx context.Context, blobKey string, scanStatus string, blockedReason string, quarantineKey string) error {
+	_, err := dao.GetBlobRefDAO().SetScanResult(ctx, blobKey, scanStatus, blockedReason, quarantineKey)
+	return err
+}
+
+func (daoScanStore) SetFilesScanResult(ctx context.Context, blobKey string, scanStatus string, blockedReason string) error {
+	_, err := dao.GetS3FileInfoDAO().SetScanResultByFileName(ctx, blobKey, scanStatus, blockedReason)
+	return err
+}
+
+func (daoScanStore) LockBlob(ctx context.Context, blobKey string) (blobLock, error) {
+	return lockBlob(ctx, blobKey)
+}
+
+type mediaScan struct {
+	store     scanStore
+	blobStore blobstore.BlobStore
+	scanner   scanner.Scanner
+}
+
+func (scan *mediaScan) scanFile(ctx context.Context, blobKey string) error {
+	blobRef, err := scan.store.FindBlobRef(ctx, blobKey)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		return err
+	}
+
+	//scanned for another copy of the content
+	if blobRef.ScanStatus == model.SCAN_STATUS_CLEAN || blobRef.ScanStatus == model.SCAN_STATUS_BLOCKED {
+		return scan.store.SetFilesScanResult(ctx, blobKey, blobRef.ScanStatus, blobRef.BlockedReason)
+	}
+
+	body, _, err := scan.blobStore.Get(ctx, blobKey)
+	if err != nil {
+		if errors.Is(err, blobstore.BlobNotFoundErr) {
+			return nil
+		}
+		return err
+	}
+	result, err := scan.scanner.Scan(ctx, body)
+	body.Close()
+	if err != nil {
+		if errors.Is(err, scanner.ScanLimitErr) {
+			return scan.quarantineBlob(ctx, blobKey, fmt.Sprintf("policy: %v", err))
+		}
+		return err
+	}
+
+	if result.Infected {
+		return scan.quarantineBlob(ctx, blobKey, result.Signature)
+	}
+
+	err = scan.store.SetBlobScanResult(ctx, blobKey, model.SCAN_STATUS_CLEAN, "", "")
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		return err
+	}
+	return scan.store.SetFilesScanResult(ctx, blobKey, model.SCAN_STATUS_CLEAN, "")
+}
+
+// quarantineBlob moves the blob out of its key and blocks its S3FileInfos, the quarantined blob is deleted with its last reference
+func (scan *mediaScan) quarantineBlob(ctx context.Context, blobKey string, reason string) error {
+	redLock, err := scan.store.LockBlob(ctx, blobKey)
+	if err != nil {
+		return err
+	}
+	defer redLock.Unlock()
+
+	quarantineKey := static.S3QuarantinePrefix + blobKey
+
+	body, blobInfo, err := scan.blobStore.Get(ctx, blobKey)
+	if err != nil {
+		if errors.Is(err, blobstore.BlobNotFoundErr) {
+			return nil
+		}
+		return err
+	}
+	_, err = scan.blobStore.Put(ctx, quarantineKey, body, blobInfo.ContentType)
+	body.Close()
+	if err != nil {
+		return fmt.Errorf("cannot quarantine blob: %w", err)
+	}
+
+	err = scan.store.SetBlobScanResult(ctx, blobKey, model.SCAN_STATUS_BLOCKED, reason, quarantineKey)
+	if err != nil {
+		//released during the scan
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return scan.blobStore.Delete(ctx, quarantineKey)
+		}
+		return err
+	}
+
+	err = scan.store.SetFilesScanResult(ctx, blobKey, model.SCAN_STATUS_BLOCKED, reason)
+	if err != nil {
+		return err
+	}
+
+	log.Println("Quarantined blob", blobKey, reason)
+	return scan.blobStore.Delete(ctx, blobKey)
+}
@@ -0,0 +1,196 @@
+package appupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"log"
+	"os"
+	"sol.go/cwm/dao"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultScanSweepInterval = 10 * 60 //seconds
+	defaultScanPendingAge    = 20 * 60 //seconds - longer than the in-process attempts
+
+	scanSweepBatchSize = 100
+	scanSweepLockName  = "s3FileInfo_scan_sweep"
+)
+
+var (
+	MediaScanSweepBusyErr = errors.New("Media scan sweep is running on another node")
+)
+
+type MediaScanSweepReport struct {
+	PendingFiles int64 `json:"pendingFiles"`
+	ScannedBlobs int64 `json:"scannedBlobs"`
+	FailedBlobs  int64 `json:"failedBlobs"` //left pending for the next run
+	Duration     int64 `json:"duration"`    //millis
+}
+
+// MediaScanSweep rescans the files still pending after PendingAge: the scanner was unavailable during the in-process retries,
+// or the node restarted before their scan
+type MediaScanSweep struct {
+	Interval   time.Duration
+	PendingAge time.Duration
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	done     chan struct{}
+}
+
+var (
+	singletonMediaScanSweep *MediaScanSweep
+	onceMediaScanSweep      sync.Once
+)
+
+// GetMediaScanSweep - MEDIA_SCAN_SWEEP_INTERVAL (seconds, default 10 mins)
+// and MEDIA_SCAN_PENDING_AGE (seconds a file waits for its in-process scan before it is swept, default 20 mins)
+func GetMediaScanSweep() *MediaScanSweep {
+	onceMediaScanSweep.Do(func() {
+		fmt.Println("Init MediaScanSweep...")
+
+		interval, err := strconv.ParseInt(os.Getenv("MEDIA_SCAN_SWEEP_INTERVAL"), 10, 64)
+		if err != nil || interval <= 0 {
+			interval = defaultScanSweepInterval
+		}
+
+		pendingAge, err := strconv.ParseInt(os.Getenv("MEDIA_SCAN_PENDING_AGE"), 10, 64)
+		if err != nil || pendingAge <= 0 {
+			pendingAge = defaultScanPendingAge
+		}
+
+		singletonMediaScanSweep = &MediaScanSweep{
+			Interval:   time.Duration(interval) * time.Second,
+			PendingAge: time.Duration(pendingAge) * time.Second,
+			stopCh:     make(chan struct{}),
+			done:       make(chan struct{}),
+		}
+	})
+	return singletonMediaScanSweep
+}
+
+func (sweep *MediaScanSweep) Start() {
+	go sweep.run()
+}
+
+func (sweep *MediaScanSweep) Stop(ctx context.Context) error {
+	sweep.stopOnce.Do(func() {
+		close(sweep.stopCh)
+	})
+
+	select {
+	case <-sweep.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("media scan sweep job is not stopped: %w", ctx.Err())
+	}
+}
+
+func (sweep *MediaScanSweep) run() {
+	defer close(sweep.done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-sweep.stopCh
+		cancel()
+	}()
+
+	ticker := time.NewTicker(sweep.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sweep.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		report, err := sweep.Run(ctx)
+		if err != nil {
+			if !errors.Is(err, MediaScanSweepBusyErr) {
+				log.Println("MediaScanSweep - error", err)
+			}
+			continue
+		}
+		if report.PendingFiles > 0 {
+			log.Printf("MediaScanSweep - %v pending files, scanned %v blobs, %v failed in %v ms\n",
+				report.PendingFiles, report.ScannedBlobs, report.FailedBlobs, report.Duration)
+		}
+	}
+}
+
+// Run scans the blobs of the pending files once, only one node runs it at a time
+func (sweep *MediaScanSweep) Run(ctx context.Context) (*MediaScanSweepReport, error) {
+	s3FileInfoDAO := dao.GetS3FileInfoDAO()
+	redLock := s3FileInfoDAO.CreateRedlockNoRetry(ctx, scanSweepLockName, sweep.Interval)
+	err := redLock.Lock()
+	if err != nil {
+		return nil, MediaScanSweepBusyErr
+	}
+	defer redLock.Unlock()
+
+	startedAt := time.Now()
+	report := &MediaScanSweepReport{}
+	createdBefore := startedAt.Add(-sweep.PendingAge).UnixMilli()
+	scanned := map[string]bool{} //the scan of a blob applies to all its files
+
+	lastId := primitive.NilObjectID
+	for {
+		s3FileInfos, err := s3FileInfoDAO.FindAllPendingScanAfter(ctx, lastId, createdBefore, scanSweepBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(s3FileInfos) == 0 {
+			break
+		}
+
+		for _, s3FileInfo := range s3FileInfos {
+			lastId = s3FileInfo.ID
+			report.PendingFiles++
+			if scanned[s3FileInfo.FileName] {
+				continue
+			}
+			scanned[s3FileInfo.FileName] = true
+
+			err = sweep.scan(ctx, s3FileInfo.FileName)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				log.Println("MediaScanSweep - cannot scan", s3FileInfo.FileId, err)
+				report.FailedBlobs++
+			} else {
+				report.ScannedBlobs++
+			}
+
+			//a scan takes up to scanTimeout, a long sweep keeps the lock
+			_, err = redLock.ExtendContext(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("media scan sweep lost its lock: %w", err)
+			}
+		}
+	}
+
+	report.Duration = time.Since(startedAt).Milliseconds()
+	return report, nil
+}
+
+// scan shares the slots of the in-process scans
+func (sweep *MediaScanSweep) scan(ctx context.Context, blobKey string) error {
+	select {
+	case scanSlots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-scanSlots }()
+
+	scanCtx, cancel := context.WithTimeout(ctx, scanTimeout)
+	defer cancel()
+	return ScanFile(scanCtx, blobKey)
+}
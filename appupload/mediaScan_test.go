@@ -0,0 +1,215 @@
+package appupload
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sol.go/cwm/blobstore"
+	"sol.go/cwm/model"
+	"sol.go/cwm/scanner"
+	"sol.go/cwm/static"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeScanStore keeps the BlobRefs and the scan status of the files of each blob in memory
+type fakeScanStore struct {
+	lock     sync.Mutex
+	blobRefs map[string]*model.BlobRef
+	files    map[string]string //blobKey -> scan status of its S3FileInfos
+	reasons  map[string]string //blobKey -> blocked reason of its S3FileInfos
+	locked   map[string]bool
+}
+
+func newFakeScanStore(blobRefs ...*model.BlobRef) *fakeScanStore {
+	store := &fakeScanStore{
+		blobRefs: map[string]*model.BlobRef{},
+		files:    map[string]string{},
+		reasons:  map[string]string{},
+		locked:   map[string]bool{},
+	}
+	for _, blobRef := range blobRefs {
+		store.blobRefs[blobRef.BlobKey] = blobRef
+		store.files[blobRef.BlobKey] = model.SCAN_STATUS_PENDING
+	}
+	return store
+}
+
+func (store *fakeScanStore) FindBlobRef(ctx context.Context, blobKey string) (*model.BlobRef, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	blobRef, ok := store.blobRefs[blobKey]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	copied := *blobRef
+	return &copied, nil
+}
+
+func (store *fakeScanStore) SetBlobScanResult(ctx context.Context, blobKey string, scanStatus string, blockedReason string, quarantineKey string) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	blobRef, ok := store.blobRefs[blobKey]
+	if !ok {
+		return mongo.ErrNoDocuments
+	}
+	blobRef.ScanStatus = scanStatus
+	blobRef.BlockedReason = blockedReason
+	blobRef.QuarantineKey = quarantineKey
+	return nil
+}
+
+func (store *fakeScanStore) SetFilesScanResult(ctx context.Context, blobKey string, scanStatus string, blockedReason string) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	store.files[blobKey] = scanStatus
+	store.reasons[blobKey] = blockedReason
+	return nil
+}
+
+func (store *fakeScanStore) LockBlob(ctx context.Context, blobKey string) (blobLock, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if store.locked[blobKey] {
+		return nil, errors.New("blob is locked")
+	}
+	store.locked[blobKey] = true
+	return &fakeBlobLock{store: store, blobKey: blobKey}, nil
+}
+
+type fakeBlobLock struct {
+	store   *fakeScanStore
+	blobKey string
+}
+
+func (blobLock *fakeBlobLock) Unlock() (bool, error) {
+	blobLock.store.lock.Lock()
+	defer blobLock.store.lock.Unlock()
+
+	delete(blobLock.store.locked, blobLock.blobKey)
+	return true, nil
+}
+
+func newTestMediaScan(t *testing.T, blobKey string, content string) (*mediaScan, *fakeScanStore, *scanner.FakeScanner) {
+	blobStore := blobstore.NewMemoryBlobStore()
+	_, err := blobStore.Put(context.Background(), blobKey, strings.NewReader(content), "application/pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := newFakeScanStore(&model.BlobRef{
+		BlobKey:    blobKey,
+		RefCount:   2,
+		ScanStatus: model.SCAN_STATUS_PENDING,
+	})
+	fakeScanner := scanner.NewFakeScanner()
+	return &mediaScan{store: store, blobStore: blobStore, scanner: fakeScanner}, store, fakeScanner
+}
+
+func TestScanFileQuarantine(t *testing.T) {
+	ctx := context.Background()
+	blobKey := "cwm_ttl_infected.pdf"
+	quarantineKey := static.S3QuarantinePrefix + blobKey
+	scan, store, _ := newTestMediaScan(t, blobKey, "%PDF-1.4 "+scanner.EICAR_TEST_FILE)
+
+	err := scan.scanFile(ctx, blobKey)
+	if err != nil {
+		t.Fatalf("scan error %v", err)
+	}
+
+	//the blob is only kept under the quarantine prefix
+	_, err = scan.blobStore.Stat(ctx, blobKey)
+	if !errors.Is(err, blobstore.BlobNotFoundErr) {
+		t.Errorf("infected blob left under its key: %v", err)
+	}
+	body, _, err := scan.blobStore.Get(ctx, quarantineKey)
+	if err != nil {
+		t.Fatalf("quarantined blob error %v", err)
+	}
+	body.Close()
+
+	blobRef := store.blobRefs[blobKey]
+	if blobRef.ScanStatus != model.SCAN_STATUS_BLOCKED || blobRef.BlockedReason != scanner.EICAR_TEST_SIGNATURE || blobRef.QuarantineKey != quarantineKey {
+		t.Errorf("blobRef = %+v", blobRef)
+	}
+	if store.files[blobKey] != model.SCAN_STATUS_BLOCKED || store.reasons[blobKey] != scanner.EICAR_TEST_SIGNATURE {
+		t.Errorf("files = %v %v", store.files[blobKey], store.reasons[blobKey])
+	}
+	if len(store.locked) > 0 {
+		t.Errorf("blob still locked: %v", store.locked)
+	}
+}
+
+func TestScanFileLimit(t *testing.T) {
+	ctx := context.Background()
+	blobKey := "cwm_ttl_large.pdf"
+	scan, store, fakeScanner := newTestMediaScan(t, blobKey, "%PDF-1.4 larger than the scan limit")
+	fakeScanner.MaxSize = 8
+
+	err := scan.scanFile(ctx, blobKey)
+	if err != nil {
+		t.Fatalf("scan error %v", err)
+	}
+
+	_, err = scan.blobStore.Stat(ctx, static.S3QuarantinePrefix+blobKey)
+	if err != nil {
+		t.Errorf("blob refused by the scanner is not quarantined: %v", err)
+	}
+	if store.files[blobKey] != model.SCAN_STATUS_BLOCKED || !strings.HasPrefix(store.reasons[blobKey], "policy: ") {
+		t.Errorf("files = %v %v", store.files[blobKey], store.reasons[blobKey])
+	}
+}
+
+func TestScanFileClean(t *testing.T) {
+	ctx := context.Background()
+	blobKey := "cwm_ttl_clean.pdf"
+	scan, store, fakeScanner := newTestMediaScan(t, blobKey, "%PDF-1.4 clean")
+
+	err := scan.scanFile(ctx, blobKey)
+	if err != nil {
+		t.Fatalf("scan error %v", err)
+	}
+
+	if store.blobRefs[blobKey].ScanStatus != model.SCAN_STATUS_CLEAN || store.files[blobKey] != model.SCAN_STATUS_CLEAN {
+		t.Errorf("blobRef %v, files %v", store.blobRefs[blobKey].ScanStatus, store.files[blobKey])
+	}
+	_, err = scan.blobStore.Stat(ctx, blobKey)
+	if err != nil {
+		t.Errorf("clean blob error %v", err)
+	}
+
+	//the result of the blob applies to the files uploaded after
+	store.files[blobKey] = model.SCAN_STATUS_PENDING
+	err = scan.scanFile(ctx, blobKey)
+	if err != nil {
+		t.Fatalf("scan again error %v", err)
+	}
+	if store.files[blobKey] != model.SCAN_STATUS_CLEAN || fakeScanner.Scanned() != 1 {
+		t.Errorf("files %v, scanned %v times", store.files[blobKey], fakeScanner.Scanned())
+	}
+}
+
+func TestScanFileUnavailable(t *testing.T) {
+	ctx := context.Background()
+	blobKey := "cwm_ttl_pending.pdf"
+	scan, store, fakeScanner := newTestMediaScan(t, blobKey, "%PDF-1.4 "+scanner.EICAR_TEST_FILE)
+	fakeScanner.Err = scanner.ScannerUnavailableErr
+
+	//the file stays pending, retried later
+	err := scan.scanFile(ctx, blobKey)
+	if !errors.Is(err, scanner.ScannerUnavailableErr) {
+		t.Errorf("scan error = %v", err)
+	}
+	if store.blobRefs[blobKey].ScanStatus != model.SCAN_STATUS_PENDING || store.files[blobKey] != model.SCAN_STATUS_PENDING {
+		t.Errorf("blobRef %v, files %v", store.blobRefs[blobKey].ScanStatus, store.files[blobKey])
+	}
+	_, err = scan.blobStore.Stat(ctx, blobKey)
+	if err != nil {
+		t.Errorf("blob error %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package appupload
+
+import (
+	"errors"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"strings"
+)
+
+var (
+	InvalidImageErr = errors.New("Invalid media image file")
+	InvalidVideoErr = errors.New("Invalid video file")
+	InvalidAudioErr = errors.New("Invalid audio file")
+	InvalidDocErr   = errors.New("Invalid doc file")
+)
+
+var docMimetypes = map[string]bool{
+	"application/pdf":   true,
+	"application/x-pdf": true,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         true,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   true,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": true,
+	"application/msword":                                         true,
+	"application/vnd.ms-word":                                    true,
+	"application/vnd.ms-powerpoint":                              true,
+	"application/mspowerpoint":                                   true,
+	"application/vnd.ms-excel":                                   true,
+	"application/msexcel":                                        true,
+	"application/vnd.oasis.opendocument.text":                    true,
+	"application/x-vnd.oasis.opendocument.text":                  true,
+	"application/vnd.oasis.opendocument.text-template":           true,
+	"application/x-vnd.oasis.opendocument.text-template":         true,
+	"application/vnd.oasis.opendocument.spreadsheet":             true,
+	"application/x-vnd.oasis.opendocument.spreadsheet":           true,
+	"application/vnd.oasis.opendocument.spreadsheet-template":    true,
+	"application/x-vnd.oasis.opendocument.spreadsheet-template":  true,
+	"application/vnd.oasis.opendocument.presentation":            true,
+	"application/x-vnd.oasis.opendocument.presentation":          true,
+	"application/vnd.oasis.opendocument.presentation-template":   true,
+	"application/x-vnd.oasis.opendocument.presentation-template": true,
+}
+
+// ValidateMediaMimetype checks the detected mimetype of a file against the media type declared by the client
+func ValidateMediaMimetype(mediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE, mimetypeString string) error {
+	switch mediaType {
+	case cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_IMAGE:
+		if !strings.HasPrefix(mimetypeString, "image") {
+			return InvalidImageErr
+		}
+	case cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_VIDEO:
+		if !strings.HasPrefix(mimetypeString, "video") {
+			return InvalidVideoErr
+		}
+	case cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_AUDIO:
+		if !strings.HasPrefix(mimetypeString, "audio") {
+			return InvalidAudioErr
+		}
+	case cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_DOC:
+		if !docMimetypes[mimetypeString] {
+			return InvalidDocErr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package appupload
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMediaURLTTL = 15 * 60 //seconds
+	minMediaURLKeySize = 32      //bytes
+
+	MEDIA_URL_PATH          = "/media"
+	MEDIA_URL_QUERY_VARIANT = "variant"
+	MEDIA_URL_QUERY_EXPIRES = "expires"
+	MEDIA_URL_QUERY_SIG     = "signature"
+
+	mediaURLSignatureVersion = "v1"
+)
+
+var (
+	MediaNotFoundErr       = errors.New("Media not found")
+	VariantNotFoundErr     = errors.New("Variant not found")
+	InvalidMediaURLErr     = errors.New("Invalid media url")
+	ExpiredMediaURLErr     = errors.New("Media url expired")
+	InvalidMediaVariantErr = errors.New("Invalid media variant")
+)
+
+// MediaURLSigner signs the urls of the http media endpoint, so a client which can not set the Authorization header
+// (video player, img tag) can download a media for a short time
+type MediaURLSigner struct {
+	TTL             time.Duration
+	BaseURL         string
+	PresignRedirect bool
+
+	key []byte //HMAC key of the signatures
+}
+
+var (
+	singletonMediaURLSigner *MediaURLSigner
+	onceMediaURLSigner      sync.Once
+)
+
+// GetMediaURLSigner - MEDIA_URL_KEY (HMAC key of the signatures, at least 32 bytes, required), MEDIA_URL_TTL (seconds, default 15 mins),
+// MEDIA_URL_BASE (scheme://host of the http server, the urls are relative if empty)
+// and MEDIA_PRESIGN_REDIRECT (redirect the downloads to the presigned urls of the BlobStore)
+func GetMediaURLSigner() *MediaURLSigner {
+	onceMediaURLSigner.Do(func() {
+		fmt.Println("Init MediaURLSigner...")
+
+		//a signed url skips the authorization of the media, a known key lets anyone download any media
+		key := os.Getenv("MEDIA_URL_KEY")
+		if len(key) < minMediaURLKeySize {
+			log.Fatalf("MEDIA_URL_KEY must be set to a secret of at least %d bytes", minMediaURLKeySize)
+		}
+
+		ttl, err := strconv.ParseInt(os.Getenv("MEDIA_URL_TTL"), 10, 64)
+		if err != nil || ttl <= 0 {
+			ttl = defaultMediaURLTTL
+		}
+
+		presignRedirect, _ := strconv.ParseBool(os.Getenv("MEDIA_PRESIGN_REDIRECT"))
+
+		singletonMediaURLSigner = &MediaURLSigner{
+			TTL:             time.Duration(ttl) * time.Second,
+			BaseURL:         strings.TrimSuffix(os.Getenv("MEDIA_URL_BASE"), "/"),
+			PresignRedirect: presignRedirect,
+			key:             []byte(key),
+		}
+	})
+	return singletonMediaURLSigner
+}
+
+// Sign returns the url of the media valid until expiresAt (unix millis)
+func (signer *MediaURLSigner) Sign(msgId string, fileId string, variant grpcCWMPb.MEDIA_VARIANT) (string, int64) {
+	expires := time.Now().Add(signer.TTL).Unix()
+
+	query := url.Values{}
+	if variant != grpcCWMPb.MEDIA_VARIANT_ORIGINAL {
+		query.Set(MEDIA_URL_QUERY_VARIANT, FormatMediaVariant(variant))
+	}
+	query.Set(MEDIA_URL_QUERY_EXPIRES, strconv.FormatInt(expires, 10))
+	query.Set(MEDIA_URL_QUERY_SIG, signer.signature(msgId, fileId, variant, expires))
+
+	return fmt.Sprintf("%s%s?%s", signer.BaseURL, MediaURLPath(msgId, fileId), query.Encode()), expires * 1000
+}
+
+// Verify checks the expires & signature query params of a signed url
+func (signer *MediaURLSigner) Verify(msgId string, fileId string, variant grpcCWMPb.MEDIA_VARIANT, expiresParam string, signature string) error {
+	expires, err := strconv.ParseInt(expiresParam, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid expires", InvalidMediaURLErr)
+	}
+
+	expected := signer.signature(msgId, fileId, variant, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return fmt.Errorf("%w: invalid signature", InvalidMediaURLErr)
+	}
+
+	if time.Now().Unix() > expires {
+		return ExpiredMediaURLErr
+	}
+	return nil
+}
+
+func MediaURLPath(msgId string, fileId string) string {
+	return fmt.Sprintf("%s/%s/%s", MEDIA_URL_PATH, url.PathEscape(msgId), url.PathEscape(fileId))
+}
+
+func (signer *MediaURLSigner) signature(msgId string, fileId string, variant grpcCWMPb.MEDIA_VARIANT, expires int64) string {
+	mac := hmac.New(sha256.New, signer.key)
+	mac.Write([]byte(strings.Join([]string{mediaURLSignatureVersion, msgId, fileId, variant.String(), strconv.FormatInt(expires, 10)}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// FormatMediaVariant - the variants are lower case in the urls, e.g. ?variant=preview
+func FormatMediaVariant(variant grpcCWMPb.MEDIA_VARIANT) string {
+	return strings.ToLower(variant.String())
+}
+
+// ParseMediaVariant - empty is the ORIGINAL
+func ParseMediaVariant(value string) (grpcCWMPb.MEDIA_VARIANT, error) {
+	if len(value) == 0 {
+		return grpcCWMPb.MEDIA_VARIANT_ORIGINAL, nil
+	}
+
+	variant, ok := grpcCWMPb.MEDIA_VARIANT_value[strings.ToUpper(value)]
+	if !ok {
+		return grpcCWMPb.MEDIA_VARIANT_ORIGINAL, fmt.Errorf("%w: %s", InvalidMediaVariantErr, value)
+	}
+	return grpcCWMPb.MEDIA_VARIANT(variant), nil
+}
+
+// FindMediaFile returns the S3FileInfo of the msg and the blob key of the variant, the files blocked or not scanned yet are refused
+func FindMediaFile(ctx context.Context, msgId string, fileId string, variant grpcCWMPb.MEDIA_VARIANT) (*model.S3FileInfo, string, error) {
+	s3FileInfo, err := dao.GetS3FileInfoDAO().FindByFileId(ctx, fileId)
+	if s3FileInfo == nil {
+		return nil, "", fmt.Errorf("%w: %v", MediaNotFoundErr, err)
+	}
+
+	if s3FileInfo.MsgId != msgId {
+		return nil, "", fmt.Errorf("%w: invalid msgId", MediaNotFoundErr)
+	}
+
+	err = CheckMediaScan(s3FileInfo)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if variant == grpcCWMPb.MEDIA_VARIANT_ORIGINAL {
+		return s3FileInfo, s3FileInfo.FileName, nil
+	}
+
+	fileVariant := s3FileInfo.FindVariant(variant)
+	if fileVariant == nil {
+		return nil, "", fmt.Errorf("%w: %v", VariantNotFoundErr, variant)
+	}
+	return s3FileInfo, fileVariant.FileName, nil
+}
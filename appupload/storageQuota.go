@@ -0,0 +1,236 @@
+package appupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"go.mongodb.org/mongo-driver/mongo"
+	"log"
+	"math"
+	"os"
+	"sol.go/cwm/dao"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultQuotaBytes   = 5 * 1024 << 20 //5 GB
+	defaultDailyUploads = 500
+	defaultDailyBytes   = 2 * 1024 << 20 //2 GB
+
+	DAILY_UPLOAD_KEY         = "uploadDaily"
+	dailyUploadFieldUploads  = "uploads"
+	dailyUploadFieldBytes    = "bytes"
+	dailyUploadKeyTTL        = 48 * time.Hour
+	dailyUploadKeyDateLayout = "20060102"
+	unlimitedUploadAllowance = math.MaxInt64
+)
+
+var (
+	StorageQuotaExceededErr = errors.New("Storage quota exceeded")
+	DailyUploadLimitErr     = errors.New("Daily upload limit reached")
+)
+
+// StorageQuota limits the bytes stored by each user and the uploads of a day (UTC), 0 - unlimited.
+// The stored bytes are counted per file: a content shared by several msgs of the user is counted for each msg
+type StorageQuota struct {
+	QuotaBytes   int64
+	DailyUploads int64
+	DailyBytes   int64
+}
+
+// StorageUsageInfo - usage of a user, the daily counters are reset at DailyResetAt (unix millis)
+type StorageUsageInfo struct {
+	StoredBytes  int64
+	FileCount    int64
+	DailyUploads int64
+	DailyBytes   int64
+	DailyResetAt int64
+}
+
+// UploadAllowance - the bytes an upload of unknown size may still store, LimitErr is the limit reached past them
+type UploadAllowance struct {
+	MaxBytes int64
+	LimitErr error
+}
+
+var (
+	singletonStorageQuota *StorageQuota
+	onceStorageQuota      sync.Once
+)
+
+// GetStorageQuota - UPLOAD_QUOTA_BYTES (bytes stored per user, default 5 GB), UPLOAD_DAILY_COUNT (uploads per day, default 500)
+// and UPLOAD_DAILY_BYTES (bytes uploaded per day, default 2 GB), 0 disables a limit
+func GetStorageQuota() *StorageQuota {
+	onceStorageQuota.Do(func() {
+		fmt.Println("Init StorageQuota...")
+
+		singletonStorageQuota = &StorageQuota{
+			QuotaBytes:   quotaLimit("UPLOAD_QUOTA_BYTES", defaultQuotaBytes),
+			DailyUploads: quotaLimit("UPLOAD_DAILY_COUNT", defaultDailyUploads),
+			DailyBytes:   quotaLimit("UPLOAD_DAILY_BYTES", defaultDailyBytes),
+		}
+	})
+	return singletonStorageQuota
+}
+
+func quotaLimit(name string, defaultValue int64) int64 {
+	value := os.Getenv(name)
+	if len(value) == 0 {
+		return defaultValue
+	}
+
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || limit < 0 {
+		log.Println("Invalid", name, value)
+		return defaultValue
+	}
+	return limit
+}
+
+// ReserveUpload counts an upload of the user in the day, fileSize is 0 if unknown before the upload.
+// The upload is rejected if it does not fit in the quota or the daily limits, the counted uploads are not given back when they fail
+func (quota *StorageQuota) ReserveUpload(ctx context.Context, phoneFull string, fileSize int64) (*UploadAllowance, error) {
+	allowance := &UploadAllowance{
+		MaxBytes: unlimitedUploadAllowance,
+	}
+
+	if quota.QuotaBytes > 0 {
+		storedBytes, err := quota.storedBytes(ctx, phoneFull)
+		if err != nil {
+			return nil, err
+		}
+		if storedBytes >= quota.QuotaBytes || storedBytes+fileSize > quota.QuotaBytes {
+			return nil, fmt.Errorf("%w: %d + %d > %d bytes", StorageQuotaExceededErr, storedBytes, fileSize, quota.QuotaBytes)
+		}
+		allowance.MaxBytes = quota.QuotaBytes - storedBytes
+		allowance.LimitErr = StorageQuotaExceededErr
+	}
+
+	if quota.DailyUploads <= 0 && quota.DailyBytes <= 0 {
+		return allowance, nil
+	}
+
+	key := dailyUploadKey(phoneFull, time.Now())
+	pipe := redisClient().TxPipeline()
+	uploadsCmd := pipe.HIncrBy(ctx, key, dailyUploadFieldUploads, 1)
+	bytesCmd := pipe.HIncrBy(ctx, key, dailyUploadFieldBytes, fileSize)
+	pipe.Expire(ctx, key, dailyUploadKeyTTL)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot count daily uploads: %w", err)
+	}
+
+	uploads, uploadedBytes := uploadsCmd.Val(), bytesCmd.Val()
+	if quota.DailyUploads > 0 && uploads > quota.DailyUploads {
+		quota.cancelReservation(ctx, key, fileSize)
+		return nil, fmt.Errorf("%w: %d uploads", DailyUploadLimitErr, quota.DailyUploads)
+	}
+	if quota.DailyBytes > 0 {
+		previousBytes := uploadedBytes - fileSize
+		if previousBytes >= quota.DailyBytes || uploadedBytes > quota.DailyBytes {
+			quota.cancelReservation(ctx, key, fileSize)
+			return nil, fmt.Errorf("%w: %d + %d > %d bytes", DailyUploadLimitErr, previousBytes, fileSize, quota.DailyBytes)
+		}
+		if quota.DailyBytes-previousBytes < allowance.MaxBytes {
+			allowance.MaxBytes = quota.DailyBytes - previousBytes
+			allowance.LimitErr = DailyUploadLimitErr
+		}
+	}
+
+	return allowance, nil
+}
+
+// AddDailyBytes counts the bytes of an upload reserved without its size
+func (quota *StorageQuota) AddDailyBytes(ctx context.Context, phoneFull string, fileSize int64) {
+	if quota.DailyBytes <= 0 {
+		return
+	}
+
+	key := dailyUploadKey(phoneFull, time.Now())
+	pipe := redisClient().TxPipeline()
+	pipe.HIncrBy(ctx, key, dailyUploadFieldBytes, fileSize)
+	pipe.Expire(ctx, key, dailyUploadKeyTTL)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		log.Println("StorageQuota - cannot count daily bytes", phoneFull, err)
+	}
+}
+
+func (quota *StorageQuota) cancelReservation(ctx context.Context, key string, fileSize int64) {
+	pipe := redisClient().TxPipeline()
+	pipe.HIncrBy(ctx, key, dailyUploadFieldUploads, -1)
+	pipe.HIncrBy(ctx, key, dailyUploadFieldBytes, -fileSize)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		log.Println("StorageQuota - cannot cancel upload reservation", key, err)
+	}
+}
+
+// AddFile counts a stored file of the user, only if it fits in the quota
+func (quota *StorageQuota) AddFile(ctx context.Context, phoneFull string, fileSize int64) error {
+	_, err := dao.GetStorageUsageDAO().IncreaseStoredBytes(ctx, phoneFull, fileSize, quota.QuotaBytes)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("%w: %d bytes", StorageQuotaExceededErr, quota.QuotaBytes)
+		}
+		return err
+	}
+	return nil
+}
+
+// RemoveFile gives back the size of a released file to its uploader
+func (quota *StorageQuota) RemoveFile(ctx context.Context, phoneFull string, fileSize int64) error {
+	_, err := dao.GetStorageUsageDAO().DecreaseStoredBytes(ctx, phoneFull, fileSize)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	return nil
+}
+
+// Usage returns the stored bytes and the uploads of the current day of the user
+func (quota *StorageQuota) Usage(ctx context.Context, phoneFull string) (*StorageUsageInfo, error) {
+	usage := &StorageUsageInfo{}
+
+	storageUsage, err := dao.GetStorageUsageDAO().FindByPhoneFull(ctx, phoneFull)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if storageUsage != nil {
+		usage.StoredBytes = storageUsage.StoredBytes
+		usage.FileCount = storageUsage.FileCount
+	}
+
+	now := time.Now().UTC()
+	values, err := redisClient().HGetAll(ctx, dailyUploadKey(phoneFull, now)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read daily uploads: %w", err)
+	}
+	usage.DailyUploads, _ = strconv.ParseInt(values[dailyUploadFieldUploads], 10, 64)
+	usage.DailyBytes, _ = strconv.ParseInt(values[dailyUploadFieldBytes], 10, 64)
+	usage.DailyResetAt = now.Truncate(24 * time.Hour).Add(24 * time.Hour).UnixMilli()
+
+	return usage, nil
+}
+
+func (quota *StorageQuota) storedBytes(ctx context.Context, phoneFull string) (int64, error) {
+	storageUsage, err := dao.GetStorageUsageDAO().FindByPhoneFull(ctx, phoneFull)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return storageUsage.StoredBytes, nil
+}
+
+// dailyUploadKey - the counters of a day (UTC) expire the day after
+func dailyUploadKey(phoneFull string, now time.Time) string {
+	return fmt.Sprintf("%v:%v:%v", DAILY_UPLOAD_KEY, phoneFull, now.UTC().Format(dailyUploadKeyDateLayout))
+}
+
+func redisClient() *redis.Client {
+	return &dao.GetCache().RedisClient
+}
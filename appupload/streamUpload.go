@@ -0,0 +1,259 @@
+package appupload
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"github.com/gabriel-vasile/mimetype"
+	"hash"
+	"log"
+	"sol.go/cwm/blobstore"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/static"
+	"sol.go/cwm/utils"
+)
+
+const (
+	sniffSize      = 3072         //bytes read by mimetype.Detect
+	streamPartSize = minChunkSize //bytes buffered before they are uploaded as a part
+)
+
+// StreamUpload hashes the chunks of a file with the md5 and uploads them as the parts of a multipart upload while they are received.
+// The multipart upload is only completed under the content-addressed name once the md5 matches the declared checksum,
+// so a blob shared by other uploads is never overwritten by a mismatching content.
+// Only the first sniffSize bytes and one part are kept in memory.
+// The images sanitized from their metadata are assembled in a temporary blob, then stored without their metadata on Close
+type StreamUpload struct {
+	ctx       context.Context
+	MediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE
+	Checksum  string //md5 declared by the client, then the md5 of the stored file
+	FileName  string //set when the file type is detected
+	MimeType  string
+	FileSize  int64
+
+	OriginalChecksum string //md5 declared by the client
+	OriginalFileSize int64
+
+	namePrefix  string
+	maxFileSize int64
+	allowance   *UploadAllowance //quota left to the uploader
+
+	header    bytes.Buffer
+	digest    hash.Hash
+	uploadId  string //multipart upload of FileName
+	part      bytes.Buffer
+	partETags map[int64]string
+	existing  bool //the blob of the content is already stored, the chunks are only checked
+	sanitize  bool //FileName is the temporary blob of the received image
+	extension string
+}
+
+func NewStreamUpload(ctx context.Context, mediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE, checksum string) *StreamUpload {
+	return &StreamUpload{
+		ctx:              ctx,
+		MediaType:        mediaType,
+		Checksum:         checksum,
+		OriginalChecksum: checksum,
+		namePrefix:       static.S3NamePrefxix,
+		maxFileSize:      static.MaxFileSize,
+		digest:           md5.New(),
+	}
+}
+
+// NewAvatarUpload - the avatars are images kept until they are replaced
+func NewAvatarUpload(ctx context.Context, checksum string) *StreamUpload {
+	upload := NewStreamUpload(ctx, cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_IMAGE, checksum)
+	upload.namePrefix = static.S3AvatarPrefix
+	upload.maxFileSize = static.MaxAvatarSize
+	return upload
+}
+
+// Restrict stops the upload once it exceeds the quota left to the uploader
+func (upload *StreamUpload) Restrict(allowance *UploadAllowance) {
+	upload.allowance = allowance
+}
+
+// Write receives the next chunk, the upload is started once the file type is detected
+func (upload *StreamUpload) Write(chunk []byte) error {
+	upload.FileSize += int64(len(chunk))
+	if upload.FileSize > upload.maxFileSize {
+		return fmt.Errorf("%w: %d > %d", InvalidFileSizeErr, upload.FileSize, upload.maxFileSize)
+	}
+	if upload.allowance != nil && upload.FileSize > upload.allowance.MaxBytes {
+		return fmt.Errorf("%w: %d > %d bytes left", upload.allowance.LimitErr, upload.FileSize, upload.allowance.MaxBytes)
+	}
+
+	if upload.started() {
+		return upload.pipe(chunk)
+	}
+
+	upload.header.Write(chunk)
+	if upload.header.Len() < sniffSize {
+		return nil
+	}
+	return upload.start()
+}
+
+// Close uploads the last part and checks the checksum, the multipart upload is completed on a match and aborted on a mismatch
+func (upload *StreamUpload) Close() error {
+	//file smaller than sniffSize
+	if !upload.started() {
+		if upload.FileSize == 0 {
+			return fmt.Errorf("%w: %d", InvalidFileSizeErr, upload.FileSize)
+		}
+
+		err := upload.start()
+		if err != nil {
+			return err
+		}
+	}
+
+	upload.OriginalFileSize = upload.FileSize
+	checksum := fmt.Sprintf("%x", upload.digest.Sum(nil))
+	if checksum != upload.Checksum {
+		log.Println("Invalid Checksum", checksum, upload.Checksum)
+		upload.Abort(ChecksumMismatchErr)
+		return fmt.Errorf("%w: %v - %v", ChecksumMismatchErr, checksum, upload.Checksum)
+	}
+
+	if upload.existing {
+		return nil
+	}
+
+	err := upload.uploadPart(true)
+	if err != nil {
+		upload.Abort(err)
+		return err
+	}
+
+	blobInfo, err := blobstore.GetBlobStore().CompleteMultipart(upload.ctx, upload.FileName, upload.uploadId, upload.partETags)
+	if err != nil {
+		upload.Abort(err)
+		return fmt.Errorf("cannot save file: %w", err)
+	}
+	upload.uploadId = ""
+
+	log.Println("Done Upload File... etag:", blobInfo.ETag)
+	if upload.sanitize {
+		return upload.closeSanitized()
+	}
+	return nil
+}
+
+// Abort stops the upload, the uploaded parts are dropped
+func (upload *StreamUpload) Abort(err error) {
+	if len(upload.uploadId) == 0 {
+		return
+	}
+
+	abortErr := blobstore.GetBlobStore().AbortMultipart(upload.ctx, upload.FileName, upload.uploadId)
+	if abortErr != nil {
+		log.Println("StreamUpload - cannot abort multipart upload", upload.FileName, err, abortErr)
+	}
+	upload.uploadId = ""
+	upload.part = bytes.Buffer{}
+}
+
+// start detects the file type from the header and starts the upload
+func (upload *StreamUpload) start() error {
+	err := ValidateChecksum(upload.OriginalChecksum)
+	if err != nil {
+		return err
+	}
+
+	mime := mimetype.Detect(upload.header.Bytes())
+	err = ValidateMediaMimetype(upload.MediaType, mime.String())
+	if err != nil {
+		return err
+	}
+
+	upload.MimeType = mime.String()
+	upload.FileName = fmt.Sprintf("%s%s%s", upload.namePrefix, upload.Checksum, mime.Extension())
+
+	//the stored image is named from the sanitized content
+	if upload.MediaType == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_IMAGE && ImageSanitizeEnabled() && CanSanitizeImage(upload.MimeType) {
+		upload.sanitize = true
+		upload.extension = mime.Extension()
+		upload.FileName = fmt.Sprintf("%stmp_%s%s", upload.namePrefix, utils.GenerateUUID(), upload.extension)
+	} else {
+		//the blobs are named from their content, a stored one is not uploaded again
+		_, err = blobstore.GetBlobStore().Stat(upload.ctx, upload.FileName)
+		if err == nil {
+			upload.existing = true
+			return upload.pipe(upload.takeHeader())
+		}
+		if !errors.Is(err, blobstore.BlobNotFoundErr) {
+			return fmt.Errorf("cannot save file: %w", err)
+		}
+	}
+
+	//nothing is visible under FileName until the multipart upload is completed
+	upload.uploadId, err = blobstore.GetBlobStore().CreateMultipart(upload.ctx, upload.FileName, mime.String())
+	if err != nil {
+		return fmt.Errorf("cannot save file: %w", err)
+	}
+	upload.partETags = map[int64]string{}
+
+	return upload.pipe(upload.takeHeader())
+}
+
+// closeSanitized stores the received image without its metadata, the temporary blob is deleted
+func (upload *StreamUpload) closeSanitized() error {
+	defer func() {
+		err := blobstore.GetBlobStore().Delete(upload.ctx, upload.FileName)
+		if err != nil {
+			log.Println("StreamUpload - cannot delete temporary blob", upload.FileName, err)
+		}
+	}()
+
+	image, err := sanitizeStoredImage(upload.ctx, upload.FileName, upload.MimeType, upload.namePrefix, upload.extension)
+	if err != nil {
+		return err
+	}
+
+	upload.FileName = image.FileName
+	upload.Checksum = image.Checksum
+	upload.FileSize = image.FileSize
+	return nil
+}
+
+func (upload *StreamUpload) started() bool {
+	return upload.existing || len(upload.uploadId) > 0
+}
+
+func (upload *StreamUpload) takeHeader() []byte {
+	header := upload.header.Bytes()
+	upload.header = bytes.Buffer{}
+	return header
+}
+
+func (upload *StreamUpload) pipe(chunk []byte) error {
+	if upload.sanitize && upload.FileSize > maxSanitizeSize {
+		return fmt.Errorf("%w: %d bytes", ImageTooLargeErr, upload.FileSize)
+	}
+
+	upload.digest.Write(chunk)
+	if upload.existing {
+		return nil
+	}
+
+	upload.part.Write(chunk)
+	return upload.uploadPart(false)
+}
+
+// uploadPart uploads the buffered bytes by parts of streamPartSize, the last part may be smaller
+func (upload *StreamUpload) uploadPart(last bool) error {
+	for upload.part.Len() >= streamPartSize || (last && upload.part.Len() > 0) {
+		data := upload.part.Next(streamPartSize)
+		partNumber := int64(len(upload.partETags)) + 1
+
+		etag, err := blobstore.GetBlobStore().UploadPart(upload.ctx, upload.FileName, upload.uploadId, partNumber, data)
+		if err != nil {
+			return fmt.Errorf("cannot save file: %w", err)
+		}
+		upload.partETags[partNumber] = etag
+	}
+	return nil
+}
@@ -0,0 +1,516 @@
+package appupload
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding"
+	"errors"
+	"fmt"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/go-redsync/redsync/v4"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"hash"
+	"log"
+	"os"
+	"path"
+	"sol.go/cwm/blobstore"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/static"
+	"sol.go/cwm/utils"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	minChunkSize     = 5 * 1024 << 10 //5 MB - S3 min part size
+	defaultUploadTTL = 24 * 60 * 60   //seconds
+
+	expiryInterval  = 10 * time.Minute
+	expiryBatchSize = 100
+	expiryLockName  = "uploadSessions_expiry"
+)
+
+var (
+	UploadNotFoundErr   = errors.New("Upload not found")
+	UploadBusyErr       = errors.New("Upload is receiving another chunk")
+	InvalidFileSizeErr  = errors.New("Invalid file size")
+	InvalidOffsetErr    = errors.New("Invalid chunk offset")
+	InvalidChunkSizeErr = errors.New("Invalid chunk size")
+	UploadIncompleteErr = errors.New("Upload is not complete")
+	ChecksumMismatchErr = errors.New("Invalid Checksum")
+	InvalidChecksumErr  = errors.New("Invalid checksum, expected a md5 of 32 lowercase hex chars")
+)
+
+// UploadManager handles the resumable uploads: the chunks are uploaded as the parts of a multipart upload of the BlobStore,
+// the state of the upload (offset, parts, md5 of the received data) is kept in mongodb so any node can receive the next chunk
+type UploadManager struct {
+	ChunkSize int64
+	TTL       time.Duration
+
+	stopOnce   sync.Once
+	stopCh     chan struct{}
+	expiryDone chan struct{}
+}
+
+var (
+	singletonUploadManager *UploadManager
+	onceUploadManager      sync.Once
+)
+
+// GetUploadManager - UPLOAD_CHUNK_SIZE (bytes, min/default 5 MB) and UPLOAD_SESSION_TTL (seconds since the last chunk, default 1 day)
+func GetUploadManager() *UploadManager {
+	onceUploadManager.Do(func() {
+		fmt.Println("Init UploadManager...")
+
+		chunkSize, err := strconv.ParseInt(os.Getenv("UPLOAD_CHUNK_SIZE"), 10, 64)
+		if err != nil || chunkSize < minChunkSize {
+			chunkSize = minChunkSize
+		}
+
+		ttl, err := strconv.ParseInt(os.Getenv("UPLOAD_SESSION_TTL"), 10, 64)
+		if err != nil || ttl <= 0 {
+			ttl = defaultUploadTTL
+		}
+
+		singletonUploadManager = &UploadManager{
+			ChunkSize:  chunkSize,
+			TTL:        time.Duration(ttl) * time.Second,
+			stopCh:     make(chan struct{}),
+			expiryDone: make(chan struct{}),
+		}
+	})
+	return singletonUploadManager
+}
+
+// ValidateChecksum - the md5 declared by the client is part of the blob key
+func ValidateChecksum(checksum string) error {
+	if len(checksum) != md5.Size*2 {
+		return fmt.Errorf("%w: %q", InvalidChecksumErr, checksum)
+	}
+	for _, c := range checksum {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return fmt.Errorf("%w: %q", InvalidChecksumErr, checksum)
+		}
+	}
+	return nil
+}
+
+// Begin creates an upload of the media of a msg sent by the user in the thread, counted in the daily uploads of the user.
+// The multipart upload is created with the first chunk, when the file type is known
+func (manager *UploadManager) Begin(ctx context.Context, phoneFull string, msgId string, threadId string, mediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE, checksum string, fileSize int64) (*model.UploadSession, error) {
+	if fileSize <= 0 || fileSize > static.MaxFileSize {
+		return nil, fmt.Errorf("%w: %d", InvalidFileSizeErr, fileSize)
+	}
+
+	err := ValidateChecksum(checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	err = BindMediaMsg(ctx, phoneFull, msgId, threadId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = GetStorageQuota().ReserveUpload(ctx, phoneFull, fileSize)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	uploadSession := &model.UploadSession{
+		UploadId:  utils.GenerateUUID(),
+		PhoneFull: phoneFull,
+		MsgId:     msgId,
+		ThreadId:  threadId,
+		MediaType: mediaType,
+		Checksum:  checksum,
+		FileSize:  fileSize,
+		ChunkSize: manager.ChunkSize,
+		Offset:    0,
+		CreatedAt: now.UnixMilli(),
+		ExpiredAt: now.Add(manager.TTL).UnixMilli(),
+	}
+
+	return dao.GetUploadSessionDAO().Save(ctx, uploadSession)
+}
+
+// Find returns the upload of the user
+func (manager *UploadManager) Find(ctx context.Context, phoneFull string, uploadId string) (*model.UploadSession, error) {
+	uploadSession, err := dao.GetUploadSessionDAO().FindByUploadId(ctx, uploadId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, UploadNotFoundErr
+		}
+		return nil, err
+	}
+
+	if uploadSession.PhoneFull != phoneFull {
+		return nil, UploadNotFoundErr
+	}
+
+	return uploadSession, nil
+}
+
+// WriteChunk uploads the chunk at offset as the next part. Every chunk has the upload's ChunkSize, except the last one
+func (manager *UploadManager) WriteChunk(ctx context.Context, phoneFull string, uploadId string, offset int64, data []byte) (*model.UploadSession, error) {
+	redLock, err := lockUpload(ctx, uploadId)
+	if err != nil {
+		return nil, err
+	}
+	defer redLock.Unlock()
+
+	uploadSession, err := manager.Find(ctx, phoneFull, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset != uploadSession.Offset {
+		return nil, fmt.Errorf("%w: %d - expected %d", InvalidOffsetErr, offset, uploadSession.Offset)
+	}
+
+	size := int64(len(data))
+	if size == 0 || offset+size > uploadSession.FileSize ||
+		(size != uploadSession.ChunkSize && offset+size != uploadSession.FileSize) {
+		return nil, fmt.Errorf("%w: %d", InvalidChunkSizeErr, size)
+	}
+
+	digest, err := restoreDigest(uploadSession.HashState)
+	if err != nil {
+		return nil, err
+	}
+	digest.Write(data)
+
+	hashState, err := digest.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	blobStore := blobstore.GetBlobStore()
+	updateFields := primitive.M{}
+
+	//first chunk - the file type is detected, the multipart upload is created
+	createdMultipart := false
+	if offset == 0 {
+		mime := mimetype.Detect(data)
+		err = ValidateMediaMimetype(uploadSession.MediaType, mime.String())
+		if err != nil {
+			return nil, err
+		}
+
+		uploadSession.FileName = fmt.Sprintf("%s%s%s", static.S3NamePrefxix, uploadSession.Checksum, mime.Extension())
+		uploadSession.MimeType = mime.String()
+
+		//the parts of an image to sanitize are assembled in a temporary blob, the stored one is named from the sanitized content
+		if uploadSession.MediaType == cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_IMAGE && ImageSanitizeEnabled() && CanSanitizeImage(uploadSession.MimeType) {
+			if uploadSession.FileSize > maxSanitizeSize {
+				return nil, fmt.Errorf("%w: %d bytes", ImageTooLargeErr, uploadSession.FileSize)
+			}
+			uploadSession.Sanitize = true
+			uploadSession.FileName = fmt.Sprintf("%stmp_%s%s", static.S3NamePrefxix, uploadSession.UploadId, mime.Extension())
+			updateFields["sanitize"] = true
+		}
+		uploadSession.S3UploadId, err = blobStore.CreateMultipart(ctx, uploadSession.FileName, uploadSession.MimeType)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create multipart upload: %w", err)
+		}
+		createdMultipart = true
+
+		updateFields["fileName"] = uploadSession.FileName
+		updateFields["mimeType"] = uploadSession.MimeType
+		updateFields["s3UploadId"] = uploadSession.S3UploadId
+	}
+
+	part := model.UploadPart{
+		PartNumber: offset/uploadSession.ChunkSize + 1,
+		Size:       size,
+	}
+	var updatedSession *model.UploadSession
+	part.ETag, err = blobStore.UploadPart(ctx, uploadSession.FileName, uploadSession.S3UploadId, part.PartNumber, data)
+	if err == nil {
+		updateFields["offset"] = offset + size
+		updateFields["hashState"] = hashState
+		updateFields["expiredAt"] = time.Now().Add(manager.TTL).UnixMilli()
+
+		update := primitive.M{
+			"$set":  updateFields,
+			"$push": primitive.M{"parts": part},
+		}
+		updatedSession, err = dao.GetUploadSessionDAO().UpdateByUploadId(ctx, uploadId, update)
+	}
+
+	if err != nil {
+		//the multipart upload of a first chunk is not recorded, the chunk is sent again on a new one
+		if createdMultipart {
+			abortMultipart(ctx, uploadSession)
+		}
+		return nil, fmt.Errorf("cannot upload chunk: %w", err)
+	}
+
+	return updatedSession, nil
+}
+
+// Complete assembles the parts once all the chunks are uploaded and the md5 matches the declared checksum,
+// the upload is dropped on a checksum mismatch. The assembly is recorded, a retry after a failed save of the file only saves it again
+func (manager *UploadManager) Complete(ctx context.Context, phoneFull string, uploadId string) (*model.S3FileInfo, error) {
+	redLock, err := lockUpload(ctx, uploadId)
+	if err != nil {
+		return nil, err
+	}
+	defer redLock.Unlock()
+
+	uploadSession, err := manager.Find(ctx, phoneFull, uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	if uploadSession.CompletedAt == 0 {
+		uploadSession, err = manager.assemble(ctx, uploadSession)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s3FileInfo := &model.S3FileInfo{
+		FileId:    utils.GenerateUUID(),
+		MsgId:     uploadSession.MsgId,
+		ThreadId:  uploadSession.ThreadId,
+		Uploader:  uploadSession.PhoneFull,
+		FileName:  uploadSession.FileName,
+		FileSize:  uploadSession.StoredFileSize,
+		Checksum:  uploadSession.StoredChecksum,
+		MediaType: uploadSession.MediaType,
+		CreatedAt: time.Now().UnixMilli(),
+
+		OriginalChecksum: uploadSession.Checksum,
+		OriginalFileSize: uploadSession.FileSize,
+	}
+
+	s3FileInfo, err = SaveFileInfo(ctx, s3FileInfo, uploadSession.MimeType)
+	if err != nil {
+		//the blob is already deleted, the upload can not be completed again
+		if errors.Is(err, StorageQuotaExceededErr) || errors.Is(err, MediaBlockedErr) || errors.Is(err, BlobDeletedErr) {
+			_, deleteErr := dao.GetUploadSessionDAO().DeleteByUploadId(ctx, uploadId)
+			if deleteErr != nil {
+				log.Println("UploadManager - cannot delete upload", uploadId, deleteErr)
+			}
+		}
+		return nil, err
+	}
+
+	_, err = dao.GetUploadSessionDAO().DeleteByUploadId(ctx, uploadId)
+	if err != nil {
+		log.Println("UploadManager - cannot delete upload", uploadId, err)
+	}
+
+	return s3FileInfo, nil
+}
+
+// assemble completes the multipart upload and sanitizes an image, then records the stored blob in the upload.
+// The upload is dropped if the blob can not be stored or recorded
+func (manager *UploadManager) assemble(ctx context.Context, uploadSession *model.UploadSession) (*model.UploadSession, error) {
+	if uploadSession.Offset != uploadSession.FileSize {
+		return nil, fmt.Errorf("%w: %d/%d", UploadIncompleteErr, uploadSession.Offset, uploadSession.FileSize)
+	}
+
+	digest, err := restoreDigest(uploadSession.HashState)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := fmt.Sprintf("%x", digest.Sum(nil))
+	if checksum != uploadSession.Checksum {
+		log.Println("Invalid Checksum", checksum, uploadSession.Checksum)
+		manager.drop(ctx, uploadSession)
+		return nil, fmt.Errorf("%w: %v - %v", ChecksumMismatchErr, checksum, uploadSession.Checksum)
+	}
+
+	partETags := map[int64]string{}
+	for _, part := range uploadSession.Parts {
+		partETags[part.PartNumber] = part.ETag
+	}
+
+	blobInfo, err := blobstore.GetBlobStore().CompleteMultipart(ctx, uploadSession.FileName, uploadSession.S3UploadId, partETags)
+	if err != nil {
+		return nil, fmt.Errorf("cannot complete multipart upload: %w", err)
+	}
+
+	log.Println("Done Upload File... etag:", blobInfo.ETag)
+
+	//the multipart upload is gone, the upload now holds the assembled blob
+	uploadSession.S3UploadId = ""
+	fileName := uploadSession.FileName
+	storedChecksum := checksum
+	storedFileSize := uploadSession.FileSize
+
+	if uploadSession.Sanitize {
+		image, err := sanitizeAssembledImage(ctx, uploadSession)
+		if err != nil {
+			manager.drop(ctx, uploadSession)
+			return nil, err
+		}
+		fileName = image.FileName
+		storedChecksum = image.Checksum
+		storedFileSize = image.FileSize
+	}
+
+	update := primitive.M{
+		"$set": primitive.M{
+			"fileName":       fileName,
+			"storedChecksum": storedChecksum,
+			"storedFileSize": storedFileSize,
+			"completedAt":    time.Now().UnixMilli(),
+		},
+		"$unset": primitive.M{"s3UploadId": ""},
+	}
+	updatedSession, err := dao.GetUploadSessionDAO().UpdateByUploadId(ctx, uploadSession.UploadId, update)
+	if err != nil {
+		//the blob is not recorded anywhere
+		uploadSession.FileName = fileName
+		uploadSession.CompletedAt = time.Now().UnixMilli()
+		manager.drop(ctx, uploadSession)
+		return nil, fmt.Errorf("cannot complete upload: %w", err)
+	}
+
+	return updatedSession, nil
+}
+
+// Start starts the job dropping the expired uploads
+func (manager *UploadManager) Start() {
+	go manager.runExpiry()
+}
+
+func (manager *UploadManager) Stop(ctx context.Context) error {
+	manager.stopOnce.Do(func() {
+		close(manager.stopCh)
+	})
+
+	select {
+	case <-manager.expiryDone:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("upload expiry job is not stopped: %w", ctx.Err())
+	}
+}
+
+func (manager *UploadManager) runExpiry() {
+	defer close(manager.expiryDone)
+
+	ticker := time.NewTicker(expiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-manager.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		manager.dropExpired(context.Background())
+	}
+}
+
+// dropExpired drops the expired uploads, only one node runs it at a time
+func (manager *UploadManager) dropExpired(ctx context.Context) {
+	uploadSessionDAO := dao.GetUploadSessionDAO()
+	redLock := uploadSessionDAO.CreateRedlockNoRetry(ctx, expiryLockName, expiryInterval)
+	err := redLock.Lock()
+	if err != nil {
+		//another node is running it
+		return
+	}
+	defer redLock.Unlock()
+
+	dropped := 0
+	for {
+		uploadSessions, err := uploadSessionDAO.FindAllExpired(ctx, time.Now().UnixMilli(), expiryBatchSize)
+		if err != nil {
+			log.Println("UploadManager - find expired uploads error", err)
+			break
+		}
+
+		for _, uploadSession := range uploadSessions {
+			manager.drop(ctx, uploadSession)
+		}
+		dropped += len(uploadSessions)
+
+		if len(uploadSessions) < expiryBatchSize {
+			break
+		}
+	}
+
+	if dropped > 0 {
+		log.Printf("UploadManager - dropped %v expired uploads\n", dropped)
+	}
+}
+
+// drop aborts the multipart upload, or deletes the assembled blob of a completed upload unless it is referred
+func (manager *UploadManager) drop(ctx context.Context, uploadSession *model.UploadSession) {
+	if uploadSession.CompletedAt > 0 {
+		err := discardBlob(ctx, uploadSession.FileName)
+		if err != nil {
+			log.Println("UploadManager - cannot delete blob", uploadSession.FileName, err)
+		}
+	} else {
+		abortMultipart(ctx, uploadSession)
+	}
+
+	_, err := dao.GetUploadSessionDAO().DeleteByUploadId(ctx, uploadSession.UploadId)
+	if err != nil {
+		log.Println("UploadManager - cannot delete upload", uploadSession.UploadId, err)
+	}
+}
+
+func abortMultipart(ctx context.Context, uploadSession *model.UploadSession) {
+	if len(uploadSession.S3UploadId) == 0 {
+		return
+	}
+
+	err := blobstore.GetBlobStore().AbortMultipart(ctx, uploadSession.FileName, uploadSession.S3UploadId)
+	if err != nil {
+		log.Println("UploadManager - cannot abort multipart upload", uploadSession.UploadId, err)
+	}
+}
+
+// sanitizeAssembledImage stores the assembled image without its metadata, the temporary blob is deleted
+func sanitizeAssembledImage(ctx context.Context, uploadSession *model.UploadSession) (*sanitizedImage, error) {
+	defer func() {
+		err := blobstore.GetBlobStore().Delete(ctx, uploadSession.FileName)
+		if err != nil {
+			log.Println("UploadManager - cannot delete temporary blob", uploadSession.FileName, err)
+		}
+	}()
+
+	return sanitizeStoredImage(ctx, uploadSession.FileName, uploadSession.MimeType, static.S3NamePrefxix, path.Ext(uploadSession.FileName))
+}
+
+// lockUpload - the chunks of an upload are received one at a time
+func lockUpload(ctx context.Context, uploadId string) (*redsync.Mutex, error) {
+	uploadSessionDAO := dao.GetUploadSessionDAO()
+	mutexName := fmt.Sprintf("%v_%v", uploadSessionDAO.CollectionName, uploadId)
+
+	redLock := uploadSessionDAO.CreateRedlockNoRetry(ctx, mutexName, uploadSessionDAO.CacheLockTTL)
+	err := redLock.Lock()
+	if err != nil {
+		return nil, UploadBusyErr
+	}
+	return redLock, nil
+}
+
+// restoreDigest restores the md5 of the uploaded chunks
+func restoreDigest(hashState []byte) (hash.Hash, error) {
+	digest := md5.New()
+	if len(hashState) == 0 {
+		return digest, nil
+	}
+
+	err := digest.(encoding.BinaryUnmarshaler).UnmarshalBinary(hashState)
+	if err != nil {
+		return nil, fmt.Errorf("cannot restore upload checksum: %w", err)
+	}
+	return digest, nil
+}
@@ -0,0 +1,171 @@
+package appwebpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sol.go/cwm/apppush"
+	"strconv"
+)
+
+const (
+	defaultTTL = 24 * 60 * 60 //seconds
+	topicSize  = 32           //max length of the Topic header
+)
+
+var (
+	WebPushNotConfiguredErr = errors.New("Web push is not configured")
+	InvalidEndpointErr      = fmt.Errorf("WebPush - subscription expired: %w", apppush.InvalidTokenErr)
+	InvalidEndpointURLErr   = errors.New("Invalid web push endpoint")
+)
+
+// AppWebPush is the Web Push PushProvider of the WEBAPP sessions
+type AppWebPush struct {
+	HTTPClient *http.Client
+	VAPID      *VAPID
+	Policy     *EndpointPolicy
+}
+
+// webPushPayload is decrypted by the service worker of the web app
+type webPushPayload struct {
+	Title string            `json:"title,omitempty"`
+	Body  string            `json:"body,omitempty"`
+	Tag   string            `json:"tag,omitempty"`
+	Data  map[string]string `json:"data"`
+}
+
+// NewAppWebPush inits the VAPID keys from VAPID_PRIVATE_KEY (base64url) and VAPID_SUBJECT (mailto: or https: contact)
+func NewAppWebPush() (*AppWebPush, error) {
+	fmt.Println("Init App WebPush...")
+
+	privateKey := os.Getenv("VAPID_PRIVATE_KEY")
+	if len(privateKey) == 0 {
+		return nil, WebPushNotConfiguredErr
+	}
+
+	vapid, err := NewVAPID(privateKey, os.Getenv("VAPID_SUBJECT"))
+	if err != nil {
+		return nil, err
+	}
+
+	policy := GetEndpointPolicy()
+	return &AppWebPush{
+		HTTPClient: policy.HTTPClient(),
+		VAPID:      vapid,
+		Policy:     policy,
+	}, nil
+}
+
+func (appWebPush *AppWebPush) Name() string {
+	return apppush.PUSH_PROVIDER_WEBPUSH
+}
+
+func (appWebPush *AppWebPush) Send(ctx context.Context, pushMsg *apppush.PushMsg) []apppush.PushResult {
+	results := []apppush.PushResult{}
+
+	payload, err := json.Marshal(webPushPayload{
+		Title: pushMsg.Title,
+		Body:  pushMsg.Body,
+		Tag:   pushMsg.CollapseKey,
+		Data:  pushMsg.Data(),
+	})
+	if err != nil {
+		log.Println("sendWebPushMsg - build payload failed", err)
+		for _, target := range pushMsg.Targets {
+			results = append(results, apppush.PushResult{Target: target, Err: err})
+		}
+		return results
+	}
+
+	for _, target := range pushMsg.Targets {
+		err := appWebPush.sendSingleMsg(ctx, target, pushMsg, payload)
+		if err != nil {
+			log.Printf("sendWebPushMsg to %v failed: %v\n", target.PhoneFull, err)
+		}
+
+		results = append(results, apppush.PushResult{
+			Target: target,
+			Err:    err,
+		})
+	}
+
+	return results
+}
+
+func (appWebPush *AppWebPush) sendSingleMsg(ctx context.Context, target apppush.PushTarget, pushMsg *apppush.PushMsg, payload []byte) error {
+	//the subscriptions registered before the policy are checked too, a refused endpoint is pruned
+	err := appWebPush.Policy.Validate(target.Token)
+	if err != nil {
+		return fmt.Errorf("%w: %v", apppush.InvalidTokenErr, err)
+	}
+
+	body, err := Encrypt(payload, target.P256dh, target.Auth)
+	if err != nil {
+		if errors.Is(err, InvalidSubscriptionKeyErr) {
+			return fmt.Errorf("%w: %v", apppush.InvalidTokenErr, err)
+		}
+		return err
+	}
+
+	authorization, err := appWebPush.VAPID.Authorization(target.Token)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, target.Token, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("(WebPush - sendSingleMsg): failed creating request -> %w", err)
+	}
+
+	ttl := pushMsg.TTL
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+	urgency := "normal"
+	if pushMsg.PriorityLevel == apppush.PUSH_PRIORITY_HIGH {
+		urgency = "high"
+	}
+
+	httpReq.Header.Set("Authorization", authorization)
+	httpReq.Header.Set("Content-Encoding", "aes128gcm")
+	httpReq.Header.Set("Content-Type", "application/octet-stream")
+	httpReq.Header.Set("TTL", strconv.FormatInt(ttl, 10))
+	httpReq.Header.Set("Urgency", urgency)
+	if len(pushMsg.CollapseKey) > 0 {
+		httpReq.Header.Set("Topic", topicOf(pushMsg.CollapseKey))
+	}
+
+	httpRes, err := appWebPush.HTTPClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("(WebPush - sendSingleMsg): failed executing request -> %w", err)
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode >= 200 && httpRes.StatusCode < 300 {
+		return nil
+	}
+
+	resBody, _ := io.ReadAll(io.LimitReader(httpRes.Body, 1024))
+	switch {
+	case httpRes.StatusCode == http.StatusNotFound || httpRes.StatusCode == http.StatusGone:
+		return InvalidEndpointErr
+	case httpRes.StatusCode == http.StatusTooManyRequests || httpRes.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("WebPush - push failed: %v - %v: %w", httpRes.StatusCode, string(resBody), apppush.RetryableErr)
+	default:
+		return fmt.Errorf("WebPush - push failed: %v - %v", httpRes.StatusCode, string(resBody))
+	}
+}
+
+// topicOf - the Topic header is up to 32 chars of the base64url alphabet
+func topicOf(collapseKey string) string {
+	hash := sha256.Sum256([]byte(collapseKey))
+	return base64.RawURLEncoding.EncodeToString(hash[:])[:topicSize]
+}
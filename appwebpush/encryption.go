@@ -0,0 +1,152 @@
+package appwebpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/hkdf"
+	"io"
+	"strings"
+)
+
+const (
+	recordSize     = 4096
+	authSecretSize = 16
+	saltSize       = 16
+	//salt(16) + rs(4) + idlen(1) + keyid(65)
+	headerSize = saltSize + 4 + 1 + 65
+	//AES-GCM tag + padding delimiter
+	recordOverhead = 16 + 1
+	// MaxPayloadSize - the plaintext which fits in a single record
+	MaxPayloadSize = recordSize - headerSize - recordOverhead
+)
+
+var (
+	InvalidSubscriptionKeyErr = errors.New("Invalid web push subscription key")
+	PayloadTooLargeErr        = errors.New("Web push payload too large")
+)
+
+// DecodeKey decodes a subscription key (p256dh / auth), browsers send them in base64url, padded or not
+func DecodeKey(key string) ([]byte, error) {
+	key = strings.TrimRight(key, "=")
+	key = strings.NewReplacer("+", "-", "/", "_").Replace(key)
+	return base64.RawURLEncoding.DecodeString(key)
+}
+
+// ValidateSubscriptionKeys - p256dh is an uncompressed P-256 point, auth is a 16 bytes secret
+func ValidateSubscriptionKeys(p256dh string, auth string) error {
+	_, err := decodeUAPublicKey(p256dh)
+	if err != nil {
+		return err
+	}
+
+	authSecret, err := DecodeKey(auth)
+	if err != nil || len(authSecret) != authSecretSize {
+		return fmt.Errorf("%w: auth", InvalidSubscriptionKeyErr)
+	}
+	return nil
+}
+
+func decodeUAPublicKey(p256dh string) ([]byte, error) {
+	uaPublic, err := DecodeKey(p256dh)
+	if err != nil {
+		return nil, fmt.Errorf("%w: p256dh", InvalidSubscriptionKeyErr)
+	}
+
+	x, _ := elliptic.Unmarshal(elliptic.P256(), uaPublic)
+	if x == nil {
+		return nil, fmt.Errorf("%w: p256dh", InvalidSubscriptionKeyErr)
+	}
+	return uaPublic, nil
+}
+
+// Encrypt encrypts the payload for the subscription keys with the aes128gcm content coding (RFC 8291, RFC 8188),
+// as a single record keyed by a new ephemeral ECDH key
+func Encrypt(payload []byte, p256dh string, auth string) ([]byte, error) {
+	if len(payload) > MaxPayloadSize {
+		return nil, PayloadTooLargeErr
+	}
+
+	uaPublic, err := decodeUAPublicKey(p256dh)
+	if err != nil {
+		return nil, err
+	}
+
+	authSecret, err := DecodeKey(auth)
+	if err != nil || len(authSecret) != authSecretSize {
+		return nil, fmt.Errorf("%w: auth", InvalidSubscriptionKeyErr)
+	}
+
+	curve := elliptic.P256()
+	asPrivate, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("(WebPush - Encrypt): failed generating key -> %w", err)
+	}
+	asPublic := elliptic.Marshal(curve, asPrivate.X, asPrivate.Y)
+
+	uaX, uaY := elliptic.Unmarshal(curve, uaPublic)
+	sharedX, _ := curve.ScalarMult(uaX, uaY, asPrivate.D.Bytes())
+	ecdhSecret := make([]byte, 32)
+	sharedX.FillBytes(ecdhSecret)
+
+	salt := make([]byte, saltSize)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return nil, fmt.Errorf("(WebPush - Encrypt): failed generating salt -> %w", err)
+	}
+
+	//IKM = HKDF(auth_secret, ecdh_secret, "WebPush: info" || 0x00 || ua_public || as_public)
+	keyInfo := append([]byte("WebPush: info\x00"), uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+	ikm, err := hkdfExpand(ecdhSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	cek, err := hkdfExpand(ikm, salt, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdfExpand(ikm, salt, []byte("Content-Encoding: nonce\x00"), 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, fmt.Errorf("(WebPush - Encrypt): failed creating cipher -> %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("(WebPush - Encrypt): failed creating gcm -> %w", err)
+	}
+
+	//0x02 - delimiter of the last record
+	plaintext := append(append([]byte{}, payload...), 0x02)
+
+	header := make([]byte, 0, headerSize)
+	header = append(header, salt...)
+	rs := make([]byte, 4)
+	binary.BigEndian.PutUint32(rs, recordSize)
+	header = append(header, rs...)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+func hkdfExpand(secret []byte, salt []byte, info []byte, length int) ([]byte, error) {
+	out := make([]byte, length)
+	_, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), out)
+	if err != nil {
+		return nil, fmt.Errorf("(WebPush - hkdfExpand): failed reading hkdf -> %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,135 @@
+package appwebpush
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// the push services of the browsers: Chrome, Firefox, Edge and Safari
+var defaultAllowedHosts = []string{
+	"fcm.googleapis.com",
+	"push.services.mozilla.com",
+	"notify.windows.com",
+	"push.apple.com",
+}
+
+// EndpointPolicy restricts the subscription endpoints to the known push services,
+// so a client can not make the server post to an internal address
+type EndpointPolicy struct {
+	AllowedHosts []string //the host or a parent domain of the endpoint
+	AllowHTTP    bool     //local push service stand-in, also allows the private addresses
+}
+
+var (
+	singletonEndpointPolicy *EndpointPolicy
+	onceEndpointPolicy      sync.Once
+)
+
+// GetEndpointPolicy - WEBPUSH_ALLOWED_HOSTS (comma separated domains, default: the push services of Chrome, Firefox, Edge and Safari)
+// and WEBPUSH_ALLOW_HTTP (default false)
+func GetEndpointPolicy() *EndpointPolicy {
+	onceEndpointPolicy.Do(func() {
+		fmt.Println("Init WebPush EndpointPolicy...")
+
+		allowedHosts := []string{}
+		for _, host := range strings.Split(os.Getenv("WEBPUSH_ALLOWED_HOSTS"), ",") {
+			host = strings.ToLower(strings.TrimSpace(host))
+			if len(host) > 0 {
+				allowedHosts = append(allowedHosts, host)
+			}
+		}
+		if len(allowedHosts) == 0 {
+			allowedHosts = defaultAllowedHosts
+		}
+
+		allowHTTP, _ := strconv.ParseBool(os.Getenv("WEBPUSH_ALLOW_HTTP"))
+
+		singletonEndpointPolicy = &EndpointPolicy{
+			AllowedHosts: allowedHosts,
+			AllowHTTP:    allowHTTP,
+		}
+	})
+	return singletonEndpointPolicy
+}
+
+// ValidateEndpoint validates the endpoint of a subscription against the EndpointPolicy
+func ValidateEndpoint(endpoint string) error {
+	return GetEndpointPolicy().Validate(endpoint)
+}
+
+// Validate - the endpoint must be an https URL of an allowed push service, http is only allowed with AllowHTTP
+func (policy *EndpointPolicy) Validate(endpoint string) error {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || len(endpointURL.Hostname()) == 0 || endpointURL.User != nil {
+		return InvalidEndpointURLErr
+	}
+
+	if endpointURL.Scheme != "https" && !(policy.AllowHTTP && endpointURL.Scheme == "http") {
+		return InvalidEndpointURLErr
+	}
+
+	host := strings.ToLower(endpointURL.Hostname())
+	for _, allowedHost := range policy.AllowedHosts {
+		if host == allowedHost || strings.HasSuffix(host, "."+allowedHost) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %v is not a known push service", InvalidEndpointURLErr, host)
+}
+
+// HTTPClient - the connections to the private, loopback and link-local addresses are refused (unless AllowHTTP),
+// checked on the resolved address, so a push service domain resolving to an internal address is refused too
+func (policy *EndpointPolicy) HTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if !policy.AllowHTTP {
+		dialer.Control = refusePrivateAddress
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, address)
+			},
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: 10 * time.Second,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		Timeout: 30 * time.Second,
+		//an endpoint redirecting to another host is not followed
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func refusePrivateAddress(network string, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		log.Println("WebPush - refused connection to", address)
+		return fmt.Errorf("%w: %v is not a public address", InvalidEndpointURLErr, address)
+	}
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified())
+}
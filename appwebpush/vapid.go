@@ -0,0 +1,118 @@
+package appwebpush
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	vapidTokenTTL     = 12 * time.Hour //push services accept up to 24h
+	vapidTokenRefresh = 1 * time.Hour  //renew the cached token before it expires
+)
+
+var (
+	InvalidVAPIDKeyErr     = errors.New("Invalid VAPID private key")
+	InvalidVAPIDSubjectErr = errors.New("Invalid VAPID subject, must be a mailto: or https: contact")
+)
+
+// VAPID signs the JWTs identifying the application server to the push services (RFC 8292)
+type VAPID struct {
+	Subject    string //mailto: or https: contact of the application server
+	privateKey *ecdsa.PrivateKey
+	publicKey  string //base64url uncompressed P-256 point, the applicationServerKey of the browser subscriptions
+
+	lock   sync.Mutex
+	tokens map[string]vapidToken //audience - token
+}
+
+type vapidToken struct {
+	token     string
+	expiredAt time.Time
+}
+
+// NewVAPID loads the private key in the web-push format: the base64url of the 32 bytes scalar,
+// the push services reject the tokens without a contact subject
+func NewVAPID(privateKeyBase64 string, subject string) (*VAPID, error) {
+	if !strings.HasPrefix(subject, "mailto:") && !strings.HasPrefix(subject, "https:") {
+		return nil, InvalidVAPIDSubjectErr
+	}
+
+	d, err := DecodeKey(privateKeyBase64)
+	if err != nil || len(d) != 32 {
+		return nil, InvalidVAPIDKeyErr
+	}
+
+	curve := elliptic.P256()
+	privateKey := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(d)}
+	privateKey.PublicKey.Curve = curve
+	privateKey.PublicKey.X, privateKey.PublicKey.Y = curve.ScalarBaseMult(d)
+
+	return &VAPID{
+		Subject:    subject,
+		privateKey: privateKey,
+		publicKey:  base64.RawURLEncoding.EncodeToString(elliptic.Marshal(curve, privateKey.X, privateKey.Y)),
+		tokens:     map[string]vapidToken{},
+	}, nil
+}
+
+// GenerateVAPIDKeys returns a new base64url private key & public key pair
+func GenerateVAPIDKeys() (string, string, error) {
+	curve := elliptic.P256()
+	privateKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	d := make([]byte, 32)
+	privateKey.D.FillBytes(d)
+	return base64.RawURLEncoding.EncodeToString(d),
+		base64.RawURLEncoding.EncodeToString(elliptic.Marshal(curve, privateKey.X, privateKey.Y)),
+		nil
+}
+
+func (vapid *VAPID) PublicKey() string {
+	return vapid.publicKey
+}
+
+// Authorization returns the vapid Authorization header for the push service of the endpoint
+func (vapid *VAPID) Authorization(endpoint string) (string, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("(VAPID - Authorization): failed parsing endpoint -> %w", err)
+	}
+	audience := fmt.Sprintf("%v://%v", endpointURL.Scheme, endpointURL.Host)
+
+	vapid.lock.Lock()
+	defer vapid.lock.Unlock()
+
+	now := time.Now()
+	cached, existed := vapid.tokens[audience]
+	if !existed || now.Add(vapidTokenRefresh).After(cached.expiredAt) {
+		expiredAt := now.Add(vapidTokenTTL)
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+			"aud": audience,
+			"exp": expiredAt.Unix(),
+			"sub": vapid.Subject,
+		})
+
+		signed, err := token.SignedString(vapid.privateKey)
+		if err != nil {
+			return "", fmt.Errorf("(VAPID - Authorization): failed signing token -> %w", err)
+		}
+
+		cached = vapidToken{token: signed, expiredAt: expiredAt}
+		vapid.tokens[audience] = cached
+	}
+
+	return fmt.Sprintf("vapid t=%v, k=%v", cached.token, vapid.publicKey), nil
+}
@@ -0,0 +1,197 @@
+package appws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/exp/slices"
+	"log"
+	"os"
+	"sol.go/cwm/apppush"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultDeliveryAckWindow = 3000 //milliseconds
+)
+
+// deliveryCoordinator - a msg is sent on the sockets first, the push is sent after the ack window
+// only to the sessions which have not confirmed receiving the msg (confirmRecieved / ConfirmReceivedMsgs).
+// The acks are read from the msg's receivedSessions, so they may come to any node
+type deliveryCoordinator struct {
+	push      pushSender
+	store     deliveryStore
+	ackWindow time.Duration
+
+	lock    sync.Mutex
+	stopped bool
+	pending map[*pendingDelivery]struct{}
+}
+
+type pendingDelivery struct {
+	msgId    string
+	pushMsgs []*apppush.PushMsg
+	timer    *time.Timer
+}
+
+// pushSender sends the push msgs, AppPush enqueues them to the push job queue
+type pushSender interface {
+	RequestSendMsg(pushMsg *apppush.PushMsg)
+}
+
+// deliveryStore reads the acks of a msg and records its pushed sessions
+type deliveryStore interface {
+	FindMsg(ctx context.Context, msgId string) (*model.SignalMsg, error)
+	AppendPushedSessions(ctx context.Context, msgId string, sessionIds []string) error
+}
+
+// daoDeliveryStore reads and updates the msgs with SignalMsgDAO
+type daoDeliveryStore struct{}
+
+func (daoDeliveryStore) FindMsg(ctx context.Context, msgId string) (*model.SignalMsg, error) {
+	return dao.GetSignalMsgDAO().FindByMsgId(ctx, msgId)
+}
+
+func (daoDeliveryStore) AppendPushedSessions(ctx context.Context, msgId string, sessionIds []string) error {
+	_, err := dao.GetSignalMsgDAO().AppendPushedSessionsByMsgId(ctx, msgId, sessionIds)
+	return err
+}
+
+// newDeliveryCoordinator - DELIVERY_ACK_WINDOW_MS (default 3000), 0 - push without waiting for the acks
+func newDeliveryCoordinator(push pushSender, store deliveryStore) *deliveryCoordinator {
+	ackWindow, err := strconv.ParseInt(os.Getenv("DELIVERY_ACK_WINDOW_MS"), 10, 64)
+	if err != nil || ackWindow < 0 {
+		ackWindow = defaultDeliveryAckWindow
+	}
+
+	return &deliveryCoordinator{
+		push:      push,
+		store:     store,
+		ackWindow: time.Duration(ackWindow) * time.Millisecond,
+		pending:   map[*pendingDelivery]struct{}{},
+	}
+}
+
+// schedule pushes the msgs after the ack window
+func (coordinator *deliveryCoordinator) schedule(msgId string, pushMsgs ...*apppush.PushMsg) {
+	delivery := &pendingDelivery{
+		msgId:    msgId,
+		pushMsgs: []*apppush.PushMsg{},
+	}
+	for _, pushMsg := range pushMsgs {
+		if len(pushMsg.Targets) > 0 {
+			delivery.pushMsgs = append(delivery.pushMsgs, pushMsg)
+		}
+	}
+	if len(delivery.pushMsgs) == 0 {
+		return
+	}
+
+	coordinator.lock.Lock()
+	if coordinator.stopped || coordinator.ackWindow == 0 {
+		coordinator.lock.Unlock()
+		coordinator.deliver(context.Background(), delivery)
+		return
+	}
+
+	coordinator.pending[delivery] = struct{}{}
+	delivery.timer = time.AfterFunc(coordinator.ackWindow, func() {
+		coordinator.lock.Lock()
+		_, existed := coordinator.pending[delivery]
+		delete(coordinator.pending, delivery)
+		coordinator.lock.Unlock()
+
+		//already delivered by flush
+		if !existed {
+			return
+		}
+		coordinator.deliver(context.Background(), delivery)
+	})
+	coordinator.lock.Unlock()
+}
+
+// deliver pushes the msgs to the sessions which have not received the msg, and records them as pushed
+func (coordinator *deliveryCoordinator) deliver(ctx context.Context, delivery *pendingDelivery) {
+	signalMsg, err := coordinator.store.FindMsg(ctx, delivery.msgId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			//the msg is deleted in the meantime
+			return
+		}
+		//can not check the acks, push to all the sessions
+		log.Println("delivery - can not load msg", delivery.msgId, err)
+		signalMsg = nil
+	}
+
+	pushedSessions := []string{}
+	for _, pushMsg := range delivery.pushMsgs {
+		targets := []apppush.PushTarget{}
+		for _, target := range pushMsg.Targets {
+			if signalMsg != nil && !shouldPushSession(signalMsg, target) {
+				continue
+			}
+			targets = append(targets, target)
+			pushedSessions = append(pushedSessions, target.SessionId)
+		}
+
+		if len(targets) == 0 {
+			continue
+		}
+
+		pushMsg.Targets = targets
+		coordinator.push.RequestSendMsg(pushMsg)
+	}
+
+	if len(pushedSessions) == 0 || signalMsg == nil {
+		return
+	}
+
+	err = coordinator.store.AppendPushedSessions(ctx, delivery.msgId, pushedSessions)
+	if err != nil {
+		log.Println("delivery - can not record pushed sessions", delivery.msgId, err)
+	}
+}
+
+// flush pushes the pending msgs without waiting for the ack window, the msgs scheduled afterward are pushed immediately
+func (coordinator *deliveryCoordinator) flush(ctx context.Context) error {
+	coordinator.lock.Lock()
+	coordinator.stopped = true
+	deliveries := make([]*pendingDelivery, 0, len(coordinator.pending))
+	for delivery := range coordinator.pending {
+		delivery.timer.Stop()
+		deliveries = append(deliveries, delivery)
+	}
+	coordinator.pending = map[*pendingDelivery]struct{}{}
+	coordinator.lock.Unlock()
+
+	for i, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return fmt.Errorf("delivery - %v msgs are not pushed: %w", len(deliveries)-i, ctx.Err())
+		}
+		coordinator.deliver(ctx, delivery)
+	}
+
+	return nil
+}
+
+// shouldPushSession - the session has not received the msg, and is in the white list of the msg if any
+func shouldPushSession(signalMsg *model.SignalMsg, target apppush.PushTarget) bool {
+	if slices.Contains(signalMsg.ReceivedSessions, target.SessionId) {
+		return false
+	}
+
+	if target.PhoneFull == signalMsg.From && len(signalMsg.FromSessionsWhiteList) > 0 {
+		return slices.Contains(signalMsg.FromSessionsWhiteList, target.SessionId)
+	}
+
+	if target.PhoneFull == signalMsg.To && len(signalMsg.ToSessionsWhiteList) > 0 {
+		return slices.Contains(signalMsg.ToSessionsWhiteList, target.SessionId)
+	}
+
+	return true
+}
@@ -0,0 +1,206 @@
+package appws
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sol.go/cwm/apppush"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDeliveryStore serves the msgs from memory and records the pushed sessions
+type fakeDeliveryStore struct {
+	lock   sync.Mutex
+	msgs   map[string]*model.SignalMsg
+	pushed map[string][]string
+	err    error
+}
+
+func newFakeDeliveryStore(signalMsgs ...*model.SignalMsg) *fakeDeliveryStore {
+	store := &fakeDeliveryStore{
+		msgs:   map[string]*model.SignalMsg{},
+		pushed: map[string][]string{},
+	}
+	for _, signalMsg := range signalMsgs {
+		store.msgs[signalMsg.MsgId] = signalMsg
+	}
+	return store
+}
+
+func (store *fakeDeliveryStore) FindMsg(ctx context.Context, msgId string) (*model.SignalMsg, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if store.err != nil {
+		return nil, store.err
+	}
+	signalMsg, existed := store.msgs[msgId]
+	if !existed {
+		return nil, mongo.ErrNoDocuments
+	}
+	return signalMsg, nil
+}
+
+func (store *fakeDeliveryStore) AppendPushedSessions(ctx context.Context, msgId string, sessionIds []string) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	store.pushed[msgId] = append(store.pushed[msgId], sessionIds...)
+	return nil
+}
+
+func (store *fakeDeliveryStore) pushedSessions(msgId string) []string {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	return store.pushed[msgId]
+}
+
+// directPushSender sends the push msgs right away through AppPush, without the redis queue
+type directPushSender struct {
+	appPush *apppush.AppPush
+}
+
+func (sender *directPushSender) RequestSendMsg(pushMsg *apppush.PushMsg) {
+	sender.appPush.HandleSendMsg(pushMsg)
+}
+
+func newTestDelivery(t *testing.T, ackWindow time.Duration, store deliveryStore) (*deliveryCoordinator, *apppush.FakePushProvider, *apppush.FakePushProvider) {
+	fcm := apppush.NewFakePushProvider(apppush.PUSH_PROVIDER_FCM)
+	apns := apppush.NewFakePushProvider(apppush.PUSH_PROVIDER_APNS)
+
+	appPush := apppush.NewAppPush()
+	appPush.SetProvider(grpcCWMPb.OS_TYPE_ANDROID, fcm)
+	appPush.SetProvider(grpcCWMPb.OS_TYPE_IOS, apns)
+	appPush.SetRecorder(apppush.NewFakePushRecorder())
+
+	coordinator := newDeliveryCoordinator(&directPushSender{appPush: appPush}, store)
+	coordinator.ackWindow = ackWindow
+	return coordinator, fcm, apns
+}
+
+func sentSessions(provider *apppush.FakePushProvider) []string {
+	sessionIds := []string{}
+	for _, pushMsg := range provider.Sent() {
+		for _, target := range pushMsg.Targets {
+			sessionIds = append(sessionIds, target.SessionId)
+		}
+	}
+	return sessionIds
+}
+
+func TestDeliveryPushesOnlyUnackedSessions(t *testing.T) {
+	signalMsg := &model.SignalMsg{
+		MsgId:            "msg-1",
+		From:             "+84900000001",
+		To:               "+84900000002",
+		ReceivedSessions: []string{"acked"},
+	}
+	store := newFakeDeliveryStore(signalMsg)
+	coordinator, fcm, apns := newTestDelivery(t, 0, store)
+
+	coordinator.schedule("msg-1", &apppush.PushMsg{
+		Targets: []apppush.PushTarget{
+			{PhoneFull: "+84900000002", SessionId: "acked", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "acked-token"},
+			{PhoneFull: "+84900000002", SessionId: "offline", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "offline-token"},
+			{PhoneFull: "+84900000002", SessionId: "ios", OsType: grpcCWMPb.OS_TYPE_IOS, Token: "ios-token"},
+		},
+		DataBase64: "bXNn",
+	})
+
+	if sessions := sentSessions(fcm); len(sessions) != 1 || sessions[0] != "offline" {
+		t.Errorf("fcm pushed = %v", sessions)
+	}
+	if sessions := sentSessions(apns); len(sessions) != 1 || sessions[0] != "ios" {
+		t.Errorf("apns pushed = %v", sessions)
+	}
+	if pushed := store.pushedSessions("msg-1"); len(pushed) != 2 {
+		t.Errorf("recorded pushed sessions = %v", pushed)
+	}
+}
+
+func TestDeliveryRespectsSessionsWhiteList(t *testing.T) {
+	signalMsg := &model.SignalMsg{
+		MsgId:               "msg-1",
+		From:                "+84900000001",
+		To:                  "+84900000002",
+		ToSessionsWhiteList: []string{"allowed"},
+	}
+	store := newFakeDeliveryStore(signalMsg)
+	coordinator, fcm, _ := newTestDelivery(t, 0, store)
+
+	coordinator.schedule("msg-1", &apppush.PushMsg{
+		Targets: []apppush.PushTarget{
+			{PhoneFull: "+84900000002", SessionId: "allowed", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "allowed-token"},
+			{PhoneFull: "+84900000002", SessionId: "other", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "other-token"},
+		},
+	})
+
+	if sessions := sentSessions(fcm); len(sessions) != 1 || sessions[0] != "allowed" {
+		t.Errorf("fcm pushed = %v", sessions)
+	}
+}
+
+func TestDeliverySkipsDeletedMsg(t *testing.T) {
+	store := newFakeDeliveryStore()
+	coordinator, fcm, _ := newTestDelivery(t, 0, store)
+
+	coordinator.schedule("deleted", &apppush.PushMsg{
+		Targets: []apppush.PushTarget{{SessionId: "offline", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "offline-token"}},
+	})
+
+	if sessions := sentSessions(fcm); len(sessions) != 0 {
+		t.Errorf("fcm pushed = %v, the deleted msg is not pushed", sessions)
+	}
+}
+
+func TestDeliveryPushesAllWhenAcksUnavailable(t *testing.T) {
+	store := newFakeDeliveryStore()
+	store.err = errors.New("mongo down")
+	coordinator, fcm, _ := newTestDelivery(t, 0, store)
+
+	coordinator.schedule("msg-1", &apppush.PushMsg{
+		Targets: []apppush.PushTarget{{SessionId: "offline", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "offline-token"}},
+	})
+
+	if sessions := sentSessions(fcm); len(sessions) != 1 {
+		t.Errorf("fcm pushed = %v, the msg is pushed when the acks can not be read", sessions)
+	}
+}
+
+func TestDeliveryWaitsForAckWindow(t *testing.T) {
+	signalMsg := &model.SignalMsg{MsgId: "msg-1"}
+	store := newFakeDeliveryStore(signalMsg)
+	coordinator, fcm, _ := newTestDelivery(t, time.Hour, store)
+
+	coordinator.schedule("msg-1", &apppush.PushMsg{
+		Targets: []apppush.PushTarget{{SessionId: "offline", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "offline-token"}},
+	})
+	if sessions := sentSessions(fcm); len(sessions) != 0 {
+		t.Fatalf("fcm pushed = %v before the ack window", sessions)
+	}
+
+	//acked on a socket meanwhile
+	store.lock.Lock()
+	signalMsg.ReceivedSessions = []string{"offline"}
+	store.lock.Unlock()
+
+	coordinator.schedule("msg-2", &apppush.PushMsg{
+		Targets: []apppush.PushTarget{{SessionId: "other", OsType: grpcCWMPb.OS_TYPE_ANDROID, Token: "other-token"}},
+	})
+	store.lock.Lock()
+	store.msgs["msg-2"] = &model.SignalMsg{MsgId: "msg-2"}
+	store.lock.Unlock()
+
+	err := coordinator.flush(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sessions := sentSessions(fcm); len(sessions) != 1 || sessions[0] != "other" {
+		t.Errorf("fcm pushed = %v after flush", sessions)
+	}
+}
@@ -0,0 +1,143 @@
+package appws
+
+import (
+	"context"
+	"golang.org/x/exp/slices"
+	"log"
+	"sol.go/cwm/apppush"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSIPPb"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"time"
+)
+
+type PUSH_MODE int32
+
+const (
+	PUSH_MODE_NOTIFY PUSH_MODE = 0 //visible notification
+	PUSH_MODE_SILENT PUSH_MODE = 1 //data only, the app syncs in background without alerting
+	PUSH_MODE_SKIP   PUSH_MODE = 2 //no push, the msg is fetched when the app opens
+)
+
+// pushLookup loads the receivers of a push and their unread counts
+type pushLookup interface {
+	FindUser(ctx context.Context, phoneFull string) (*model.User, error)
+	TotalUnread(ctx context.Context, phoneFull string) (int64, error)
+}
+
+// daoPushLookup reads the users and the unread counters from the DAOs
+type daoPushLookup struct{}
+
+func (daoPushLookup) FindUser(ctx context.Context, phoneFull string) (*model.User, error) {
+	return dao.GetUserDAO().FindByPhoneFull(ctx, phoneFull)
+}
+
+func (daoPushLookup) TotalUnread(ctx context.Context, phoneFull string) (int64, error) {
+	return dao.GetUnreadCounterDAO().TotalByPhoneFull(ctx, phoneFull)
+}
+
+// pushSelection splits the sessions of the msg's receivers by the push mode of their users
+type pushSelection struct {
+	lookup        pushLookup
+	from          string
+	signalMessage *cwmSignalMsgPb.SignalMessage
+	now           time.Time
+
+	notifyTargets []apppush.PushTarget
+	silentTargets []apppush.PushTarget
+}
+
+func newPushSelection(lookup pushLookup, from string, signalMessage *cwmSignalMsgPb.SignalMessage) *pushSelection {
+	return &pushSelection{
+		lookup:        lookup,
+		from:          from,
+		signalMessage: signalMessage,
+		now:           time.Now(),
+		notifyTargets: []apppush.PushTarget{},
+		silentTargets: []apppush.PushTarget{},
+	}
+}
+
+// add selects the sessions of phoneFull
+func (selection *pushSelection) add(phoneFull string) {
+	user, err := selection.lookup.FindUser(context.Background(), phoneFull)
+	if err != nil {
+		log.Println("checkAndSendPushNotification - error", phoneFull, err)
+		return
+	}
+
+	switch pushModeOf(user, selection.from, selection.signalMessage, selection.now) {
+	case PUSH_MODE_NOTIFY:
+		selection.notifyTargets = append(selection.notifyTargets, selection.withBadge(pushTargetsOf(user), phoneFull)...)
+	case PUSH_MODE_SILENT:
+		selection.silentTargets = append(selection.silentTargets, selection.withBadge(pushTargetsOf(user), phoneFull)...)
+	}
+}
+
+// pushMsgs returns the visible push of the notified targets and the data only push of the silent targets
+func (selection *pushSelection) pushMsgs(thread *model.SignalThread, header *cwmSIPPb.CWMRequestHeader, dataBase64 string) (*apppush.PushMsg, *apppush.PushMsg) {
+	signalMessage := selection.signalMessage
+	pushMsg := &apppush.PushMsg{
+		Targets:       selection.notifyTargets,
+		DataBase64:    dataBase64,
+		PriorityLevel: pushPriorityOf(signalMessage.GetImType()),
+		ThreadId:      signalMessage.GetThreadId(),
+		MsgId:         signalMessage.GetMsgId(),
+		Notification:  apppush.NewPushNotification(thread, header, signalMessage),
+		TTL:           apppush.NotificationTTL(),
+	}
+	if pushMsg.Notification != nil {
+		pushMsg.CollapseKey = pushMsg.Notification.CollapseKey()
+	}
+
+	silentPushMsg := *pushMsg
+	silentPushMsg.Targets = selection.silentTargets
+	silentPushMsg.PriorityLevel = apppush.PUSH_PRIORITY_NORMAL
+	silentPushMsg.Notification = nil
+
+	return pushMsg, &silentPushMsg
+}
+
+// withBadge sets the unread count of the user on the targets, the targets are left without badge if it can not be counted
+func (selection *pushSelection) withBadge(pushTargets []apppush.PushTarget, phoneFull string) []apppush.PushTarget {
+	if len(pushTargets) == 0 {
+		return pushTargets
+	}
+
+	badge, err := selection.lookup.TotalUnread(context.Background(), phoneFull)
+	if err != nil {
+		log.Println("withBadge - error", phoneFull, err)
+		return pushTargets
+	}
+
+	for i := range pushTargets {
+		pushTargets[i].Badge = &badge
+	}
+	return pushTargets
+}
+
+// pushModeOf - the sender's own sessions are synced silently. A muted thread is not pushed,
+// a "mentions only" thread without a mention of the user and the do not disturb hours are downgraded to silent
+func pushModeOf(user *model.User, from string, signalMessage *cwmSignalMsgPb.SignalMessage, now time.Time) PUSH_MODE {
+	if user.PhoneFull == from {
+		return PUSH_MODE_SILENT
+	}
+
+	setting := user.GetThreadNotificationSetting(signalMessage.GetThreadId())
+	if setting != nil {
+		if setting.IsMuted(now) {
+			return PUSH_MODE_SKIP
+		}
+
+		if setting.MentionsOnly && !slices.Contains(signalMessage.GetMentions(), user.PhoneFull) {
+			return PUSH_MODE_SILENT
+		}
+	}
+
+	if user.DoNotDisturb != nil && user.DoNotDisturb.IsActive(now) {
+		return PUSH_MODE_SILENT
+	}
+
+	return PUSH_MODE_NOTIFY
+}
@@ -0,0 +1,216 @@
+package appws
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sol.go/cwm/apppush"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSIPPb"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"testing"
+	"time"
+)
+
+// fakePushLookup serves the users and the unread counts from memory
+type fakePushLookup struct {
+	users  map[string]*model.User
+	unread map[string]int64
+}
+
+func newFakePushLookup(users ...*model.User) *fakePushLookup {
+	lookup := &fakePushLookup{
+		users:  map[string]*model.User{},
+		unread: map[string]int64{},
+	}
+	for _, user := range users {
+		lookup.users[user.PhoneFull] = user
+	}
+	return lookup
+}
+
+func (lookup *fakePushLookup) FindUser(ctx context.Context, phoneFull string) (*model.User, error) {
+	user, existed := lookup.users[phoneFull]
+	if !existed {
+		return nil, mongo.ErrNoDocuments
+	}
+	return user, nil
+}
+
+func (lookup *fakePushLookup) TotalUnread(ctx context.Context, phoneFull string) (int64, error) {
+	unread, existed := lookup.unread[phoneFull]
+	if !existed {
+		return 0, errors.New("no unread counter")
+	}
+	return unread, nil
+}
+
+func androidSession(sessionId string) model.UserSession {
+	return model.UserSession{SessionId: sessionId, OsType: grpcCWMPb.OS_TYPE_ANDROID, PushtokenID: sessionId + "-fcm"}
+}
+
+func TestPushTargetsOf(t *testing.T) {
+	user := &model.User{
+		PhoneFull: "+84900000001",
+		Sessions: []model.UserSession{
+			androidSession("android"),
+			{SessionId: "ios", OsType: grpcCWMPb.OS_TYPE_IOS, PushtokenID: "ios-voip", SecondaryPushtokenID: "ios-remote", BundleId: "vn.sol.cwm"},
+			{SessionId: "ios-voip-only", OsType: grpcCWMPb.OS_TYPE_IOS, PushtokenID: "voip-only"},
+			{SessionId: "web", OsType: grpcCWMPb.OS_TYPE_WEBAPP, PushtokenID: "https://push.example/endpoint", WebPushP256dh: "p256dh", WebPushAuth: "auth"},
+			{SessionId: "no-token", OsType: grpcCWMPb.OS_TYPE_ANDROID},
+		},
+	}
+
+	targets := pushTargetsOf(user)
+	if len(targets) != 4 {
+		t.Fatalf("targets = %+v, the session without token is not pushed", targets)
+	}
+
+	want := []struct {
+		sessionId string
+		token     string
+		voip      bool
+	}{
+		{"android", "android-fcm", false},
+		{"ios", "ios-remote", false},
+		{"ios-voip-only", "voip-only", true},
+		{"web", "https://push.example/endpoint", false},
+	}
+	for i, w := range want {
+		if targets[i].SessionId != w.sessionId || targets[i].Token != w.token || targets[i].VoIP != w.voip {
+			t.Errorf("target %v = %+v, want %+v", i, targets[i], w)
+		}
+	}
+	if targets[1].BundleId != "vn.sol.cwm" || targets[3].P256dh != "p256dh" || targets[3].Auth != "auth" {
+		t.Errorf("targets = %+v", targets)
+	}
+}
+
+func TestPushModeOf(t *testing.T) {
+	now := time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+	signalMessage := &cwmSignalMsgPb.SignalMessage{ThreadId: "thread-1", Mentions: []string{"+84900000009"}}
+
+	tests := []struct {
+		name string
+		user *model.User
+		from string
+		want PUSH_MODE
+	}{
+		{
+			name: "default",
+			user: &model.User{PhoneFull: "+84900000001"},
+			from: "+84900000002",
+			want: PUSH_MODE_NOTIFY,
+		},
+		{
+			name: "sender",
+			user: &model.User{PhoneFull: "+84900000001"},
+			from: "+84900000001",
+			want: PUSH_MODE_SILENT,
+		},
+		{
+			name: "muted",
+			user: &model.User{PhoneFull: "+84900000001", ThreadNotificationSettings: []model.ThreadNotificationSetting{
+				{ThreadId: "thread-1", MutedUntil: model.MUTED_UNTIL_UNMUTE},
+			}},
+			from: "+84900000002",
+			want: PUSH_MODE_SKIP,
+		},
+		{
+			name: "mute expired",
+			user: &model.User{PhoneFull: "+84900000001", ThreadNotificationSettings: []model.ThreadNotificationSetting{
+				{ThreadId: "thread-1", MutedUntil: now.Add(-time.Minute).UnixMilli()},
+			}},
+			from: "+84900000002",
+			want: PUSH_MODE_NOTIFY,
+		},
+		{
+			name: "muted other thread",
+			user: &model.User{PhoneFull: "+84900000001", ThreadNotificationSettings: []model.ThreadNotificationSetting{
+				{ThreadId: "thread-2", MutedUntil: model.MUTED_UNTIL_UNMUTE},
+			}},
+			from: "+84900000002",
+			want: PUSH_MODE_NOTIFY,
+		},
+		{
+			name: "mentions only without mention",
+			user: &model.User{PhoneFull: "+84900000001", ThreadNotificationSettings: []model.ThreadNotificationSetting{
+				{ThreadId: "thread-1", MentionsOnly: true},
+			}},
+			from: "+84900000002",
+			want: PUSH_MODE_SILENT,
+		},
+		{
+			name: "mentions only with mention",
+			user: &model.User{PhoneFull: "+84900000009", ThreadNotificationSettings: []model.ThreadNotificationSetting{
+				{ThreadId: "thread-1", MentionsOnly: true},
+			}},
+			from: "+84900000002",
+			want: PUSH_MODE_NOTIFY,
+		},
+		{
+			name: "do not disturb",
+			user: &model.User{PhoneFull: "+84900000001", DoNotDisturb: &model.DoNotDisturbSchedule{
+				Enabled: true, StartMinute: 22 * 60, EndMinute: 7 * 60, Timezone: "UTC",
+			}},
+			from: "+84900000002",
+			want: PUSH_MODE_SILENT,
+		},
+	}
+
+	for _, test := range tests {
+		if got := pushModeOf(test.user, test.from, signalMessage, now); got != test.want {
+			t.Errorf("%v: push mode = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestPushSelection(t *testing.T) {
+	sender := &model.User{PhoneFull: "+84900000001", Sessions: []model.UserSession{androidSession("sender")}}
+	receiver := &model.User{PhoneFull: "+84900000002", Sessions: []model.UserSession{androidSession("receiver")}}
+	muted := &model.User{
+		PhoneFull: "+84900000003",
+		Sessions:  []model.UserSession{androidSession("muted")},
+		ThreadNotificationSettings: []model.ThreadNotificationSetting{
+			{ThreadId: "thread-1", MutedUntil: model.MUTED_UNTIL_UNMUTE},
+		},
+	}
+	lookup := newFakePushLookup(sender, receiver, muted)
+	lookup.unread[receiver.PhoneFull] = 5
+
+	signalMessage := &cwmSignalMsgPb.SignalMessage{
+		ThreadId: "thread-1",
+		MsgId:    "msg-1",
+		ImType:   cwmSignalMsgPb.SIGNAL_IM_TYPE_IM,
+		Data:     []byte("hello"),
+	}
+	selection := newPushSelection(lookup, sender.PhoneFull, signalMessage)
+	for _, phoneFull := range []string{sender.PhoneFull, receiver.PhoneFull, muted.PhoneFull, "+84900000404"} {
+		selection.add(phoneFull)
+	}
+
+	thread := &model.SignalThread{ThreadId: "thread-1", Type: cwmSignalMsgPb.SIGNAL_THREAD_TYPE_SOLO}
+	header := &cwmSIPPb.CWMRequestHeader{From: sender.PhoneFull, FromFirstName: "Alice"}
+	pushMsg, silentPushMsg := selection.pushMsgs(thread, header, "bXNn")
+
+	if len(pushMsg.Targets) != 1 || pushMsg.Targets[0].SessionId != "receiver" {
+		t.Fatalf("notify targets = %+v", pushMsg.Targets)
+	}
+	if badge := pushMsg.Targets[0].Badge; badge == nil || *badge != 5 {
+		t.Errorf("receiver badge = %v, want 5", badge)
+	}
+	if pushMsg.Notification == nil || pushMsg.Notification.SenderName != "Alice" || pushMsg.CollapseKey != pushMsg.Notification.CollapseKey() {
+		t.Errorf("notification = %+v - collapse key %v", pushMsg.Notification, pushMsg.CollapseKey)
+	}
+
+	if len(silentPushMsg.Targets) != 1 || silentPushMsg.Targets[0].SessionId != "sender" {
+		t.Fatalf("silent targets = %+v", silentPushMsg.Targets)
+	}
+	if silentPushMsg.Targets[0].Badge != nil {
+		t.Errorf("sender badge = %v, the badge is left unset when it can not be counted", *silentPushMsg.Targets[0].Badge)
+	}
+	if silentPushMsg.Notification != nil || silentPushMsg.PriorityLevel != apppush.PUSH_PRIORITY_NORMAL {
+		t.Errorf("silent push = %+v", silentPushMsg)
+	}
+}
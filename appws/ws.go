@@ -9,18 +9,18 @@ import (
 	socketio "github.com/googollee/go-socket.io"
 	"github.com/googollee/go-socket.io/engineio"
 	"github.com/googollee/go-socket.io/engineio/transport"
+	"github.com/googollee/go-socket.io/engineio/transport/polling"
 	"github.com/googollee/go-socket.io/engineio/transport/websocket"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/proto"
 	"log"
 	"os"
-	"sol.go/cwm/appfirebase"
+	"sol.go/cwm/apppush"
 	"sol.go/cwm/dao"
 	"sol.go/cwm/model"
 	"sol.go/cwm/proto/cwmSIPPb"
 	"sol.go/cwm/proto/cwmSignalMsgPb"
-	"sol.go/cwm/proto/grpcCWMPb"
 	"sol.go/cwm/sip"
 	"sol.go/cwm/utils"
 	"strings"
@@ -41,6 +41,13 @@ type WsCreds struct {
 type WS struct {
 	Server           *socketio.Server
 	SendWSMsgChannel chan *WSSendMsg
+	Push             *apppush.AppPush
+
+	lookup         pushLookup
+	delivery       *deliveryCoordinator
+	sendLock       sync.RWMutex
+	stopped        bool
+	sendWorkerDone chan struct{}
 }
 
 type SocketInfo struct {
@@ -55,6 +62,11 @@ type WSSendMsg struct {
 
 const (
 	WS_CRED_AUTH = "authorization"
+
+	WS_EVENT_SERVER_SHUTDOWN = "onServerShutdown"
+
+	WS_TRANSPORT_WEBSOCKET = "websocket"
+	WS_TRANSPORT_POLLING   = "polling"
 )
 
 var (
@@ -77,8 +89,12 @@ func GetWS() *WS {
 			log.Fatalf("Failed to init WS: %v", err)
 		}
 
+		push := apppush.GetAppPush()
 		singletonWS = &WS{
-			Server: server,
+			Server:   server,
+			Push:     push,
+			lookup:   daoPushLookup{},
+			delivery: newDeliveryCoordinator(push, daoDeliveryStore{}),
 		}
 	})
 	return singletonWS
@@ -86,7 +102,9 @@ func GetWS() *WS {
 
 func (ws *WS) Start() {
 	sendWSMsgChannel := make(chan *WSSendMsg, 1000) //make a buffered channel size 1000
+	sendWorkerDone := make(chan struct{})
 	go func(sendWSMsgChannel chan *WSSendMsg) {
+		defer close(sendWorkerDone)
 		for wsSendMsg := range sendWSMsgChannel {
 			err := ws.doSendMsg(wsSendMsg.Thread, wsSendMsg.CWMReq)
 			if err != nil {
@@ -96,26 +114,84 @@ func (ws *WS) Start() {
 	}(sendWSMsgChannel)
 
 	ws.SendWSMsgChannel = sendWSMsgChannel
+	ws.sendWorkerDone = sendWorkerDone
 }
 
 func (ws *WS) SendMsg(thread *model.SignalThread, req *cwmSIPPb.CWMRequest) {
+	ws.sendLock.RLock()
+	defer ws.sendLock.RUnlock()
+
+	if ws.stopped {
+		//msg is already saved, client will fetch it by FetchAllUnreceivedMsg
+		log.Println("socketio - sendMsg after stopped, drop msg from", req.GetHeader().GetFrom())
+		return
+	}
+
 	ws.SendWSMsgChannel <- &WSSendMsg{
 		Thread: thread,
 		CWMReq: req,
 	}
 }
 
-func WSServer() (*socketio.Server, error) {
-	wsTransport := websocket.Default
-	//pollingTransport := polling.Default
+// CloseSockets tells the sockets connected to this node to reconnect to another node, then closes them
+func (ws *WS) CloseSockets(ctx context.Context) error {
+	rWlock.RLock()
+	rooms := make([]string, 0, len(socketlist))
+	for phoneFull := range socketlist {
+		rooms = append(rooms, phoneFull)
+	}
+	rWlock.RUnlock()
 
+	conns := []socketio.Conn{}
+	for _, room := range rooms {
+		ws.Server.ForEach("/", room, func(s socketio.Conn) {
+			conns = append(conns, s)
+		})
+	}
+
+	for _, s := range conns {
+		s.Emit(WS_EVENT_SERVER_SHUTDOWN)
+	}
+
+	//give the emitted events a moment to be flushed before closing
+	select {
+	case <-time.After(time.Second):
+	case <-ctx.Done():
+	}
+
+	for _, s := range conns {
+		_ = s.Close()
+	}
+
+	log.Printf("socketio - closed %v sockets\n", len(conns))
+	return nil
+}
+
+// Stop stops accepting msgs, waits until all queued msgs are sent, then pushes the msgs waiting for the socket acks
+func (ws *WS) Stop(ctx context.Context) error {
+	ws.sendLock.Lock()
+	if ws.stopped {
+		ws.sendLock.Unlock()
+		return nil
+	}
+	ws.stopped = true
+	close(ws.SendWSMsgChannel)
+	ws.sendLock.Unlock()
+
+	select {
+	case <-ws.sendWorkerDone:
+	case <-ctx.Done():
+		return fmt.Errorf("socketio - %v msgs are not sent: %w", len(ws.SendWSMsgChannel), ctx.Err())
+	}
+
+	return ws.delivery.flush(ctx)
+}
+
+func WSServer() (*socketio.Server, error) {
 	opts := &engineio.Options{
 		PingTimeout:  15 * time.Second,
 		PingInterval: 10 * time.Second,
-		Transports: []transport.Transport{
-			wsTransport,
-			//pollingTransport,
-		},
+		Transports:   wsTransports(),
 	}
 
 	server := socketio.NewServer(opts)
@@ -340,6 +416,35 @@ func WSServer() (*socketio.Server, error) {
 	return server, nil
 }
 
+// wsTransports returns the transports enabled by WS_TRANSPORTS (default: websocket,polling).
+// Polling is the fallback for networks that block websocket upgrades, clients start with polling and upgrade to websocket when possible.
+// Events, acks and room broadcasts (via the redis adapter) are the same on both transports,
+// but a polling session lives on a single node, so the load balancer must use sticky sessions
+func wsTransports() []transport.Transport {
+	transportNames := os.Getenv("WS_TRANSPORTS")
+	if len(transportNames) == 0 {
+		transportNames = fmt.Sprintf("%v,%v", WS_TRANSPORT_WEBSOCKET, WS_TRANSPORT_POLLING)
+	}
+
+	transports := []transport.Transport{}
+	for _, name := range strings.Split(transportNames, ",") {
+		switch strings.TrimSpace(name) {
+		case WS_TRANSPORT_WEBSOCKET:
+			transports = append(transports, websocket.Default)
+		case WS_TRANSPORT_POLLING:
+			transports = append(transports, polling.Default)
+		default:
+			log.Println("WS - unknown transport:", name)
+		}
+	}
+
+	if len(transports) == 0 {
+		log.Fatalf("Failed to init WS: no valid transport in WS_TRANSPORTS: %v", transportNames)
+	}
+
+	return transports
+}
+
 func (ws *WS) doSendMsg(thread *model.SignalThread, req *cwmSIPPb.CWMRequest) error {
 	data, err := proto.Marshal(req)
 	if err != nil {
@@ -361,130 +466,69 @@ func (ws *WS) doSendMsg(thread *model.SignalThread, req *cwmSIPPb.CWMRequest) er
 
 	//log.Println("socketio - sendMsg - imtype:", signalMessage.GetImType(), shouldPushRemoteWakeup)
 
-	offlineAndroidSessions := []model.UserSession{}
-	offlineIOSSessions := []model.UserSession{}
-
-	rWlock.RLock()
-	fromSockets, existed := socketlist[req.GetHeader().GetFrom()] //PhoneFull
-	rWlock.RUnlock()
-	if existed && len(fromSockets) > 0 {
-		//server.BroadcastToNamespace("/", "onChatMsg", data) //client will receive in base64 string
-		ws.Server.BroadcastToRoom("/", req.GetHeader().GetFrom(), "onChatMsg", dataBase64) //client will receive in base64 string
-	}
-	if shouldPushRemoteWakeup {
-		fromOfflineAndroidSessions, fromOfflineIosSessions := findOfflineSessions(req.GetHeader().GetFrom(), fromSockets)
-		offlineAndroidSessions = append(offlineAndroidSessions, fromOfflineAndroidSessions...)
-		offlineIOSSessions = append(offlineIOSSessions, fromOfflineIosSessions...)
-	}
-
+	receivers := []string{req.GetHeader().GetFrom()} //PhoneFull
 	if thread.Type == cwmSignalMsgPb.SIGNAL_THREAD_TYPE_SOLO {
-		rWlock.RLock()
-		toSockets, existed := socketlist[req.GetHeader().GetTo()] //PhoneFull
-		rWlock.RUnlock()
-		if existed && len(toSockets) > 0 {
-			//log.Println("send ws to", req.GetHeader().GetTo())
-			ws.Server.BroadcastToRoom("/", req.GetHeader().GetTo(), "onChatMsg", dataBase64) //client will receive in base64 string
-		}
-		if shouldPushRemoteWakeup {
-			toOfflineAndroidSessions, toOfflineIosSessions := findOfflineSessions(req.GetHeader().GetTo(), toSockets)
-			offlineAndroidSessions = append(offlineAndroidSessions, toOfflineAndroidSessions...)
-			offlineIOSSessions = append(offlineIOSSessions, toOfflineIosSessions...)
-		}
-
+		receivers = append(receivers, req.GetHeader().GetTo())
 	} else if thread.Type == cwmSignalMsgPb.SIGNAL_THREAD_TYPE_GROUP {
 		for _, participant := range thread.Participants {
 			if participant != req.GetHeader().GetFrom() {
-				rWlock.RLock()
-				participantSockets, existed := socketlist[participant] //PhoneFull
-				rWlock.RUnlock()
-				if existed && len(participantSockets) > 0 {
-					ws.Server.BroadcastToRoom("/", participant, "onChatMsg", dataBase64) //client will receive in base64 string
-				}
-				if shouldPushRemoteWakeup {
-					participantOfflineAndroidSessions, participantOfflineIosSessions := findOfflineSessions(participant, participantSockets)
-					offlineAndroidSessions = append(offlineAndroidSessions, participantOfflineAndroidSessions...)
-					offlineIOSSessions = append(offlineIOSSessions, participantOfflineIosSessions...)
-				}
+				receivers = append(receivers, participant)
 			}
 		}
 	}
 
+	selection := newPushSelection(ws.lookup, req.GetHeader().GetFrom(), signalMessage)
+	for _, receiver := range receivers {
+		//the room is broadcast through the redis adapter, so the sockets connected to the other nodes receive the msg too
+		ws.Server.BroadcastToRoom("/", receiver, "onChatMsg", dataBase64) //client will receive in base64 string
+		if shouldPushRemoteWakeup {
+			selection.add(receiver)
+		}
+	}
+
 	if !shouldPushRemoteWakeup {
 		return nil
 	}
 
-	////priority low
-	if signalMessage.GetImType() > cwmSignalMsgPb.SIGNAL_IM_TYPE_TYPING && signalMessage.GetImType() <= cwmSignalMsgPb.SIGNAL_IM_TYPE_EVENT {
-		if len(offlineAndroidSessions) > 0 {
-			go sendFCMMsg(offlineAndroidSessions, dataBase64, appfirebase.FCM_PRIORITY_NORMAL)
-		}
-		if len(offlineIOSSessions) > 0 {
-			//TODO - send APNS msg
-		}
-	} else if signalMessage.GetImType() > cwmSignalMsgPb.SIGNAL_IM_TYPE_EVENT {
-		if len(offlineAndroidSessions) > 0 {
-			go sendFCMMsg(offlineAndroidSessions, dataBase64, appfirebase.FCM_PRIORITY_HIGH)
-		}
-		if len(offlineIOSSessions) > 0 {
-			//TODO - send APNS msg
-		}
-	}
+	pushMsg, silentPushMsg := selection.pushMsgs(thread, req.GetHeader(), dataBase64)
+	ws.delivery.schedule(signalMessage.GetMsgId(), pushMsg, silentPushMsg)
 
 	return nil
 }
 
-func findOfflineSessions(phoneFull string, sockets []SocketInfo) ([]model.UserSession, []model.UserSession) {
-	androidSessions := []model.UserSession{}
-	iosSessions := []model.UserSession{}
-
-	user, err := dao.GetUserDAO().FindByPhoneFull(context.Background(), phoneFull)
-	if err != nil {
-		log.Println("checkAndSendPushNotification - error", phoneFull, err)
-		return androidSessions, iosSessions
+func pushPriorityOf(imType cwmSignalMsgPb.SIGNAL_IM_TYPE) apppush.PUSH_PRIORITY {
+	if imType > cwmSignalMsgPb.SIGNAL_IM_TYPE_EVENT {
+		return apppush.NotificationPriority()
 	}
+	return apppush.PUSH_PRIORITY_NORMAL
+}
 
-	if sockets == nil || len(sockets) == 0 {
-		for _, session := range user.Sessions {
-			if session.OsType == grpcCWMPb.OS_TYPE_ANDROID {
-				if len(session.PushtokenID) > 0 {
-					androidSessions = append(androidSessions, session)
-				}
-			} else if session.OsType == grpcCWMPb.OS_TYPE_IOS {
-				if len(session.PushtokenID) > 0 {
-					iosSessions = append(androidSessions, session)
-				}
-			}
+// pushTargetsOf returns the push targets of the user's sessions, the sessions which ack the socket delivery are not pushed.
+// An iOS session is pushed on its APNS_REMOTE token, or on its APNS_VOIP token if it registered only the VoIP one
+func pushTargetsOf(user *model.User) []apppush.PushTarget {
+	pushTargets := []apppush.PushTarget{}
+
+	for _, session := range user.Sessions {
+		token, voip := session.RemotePushToken(), false
+		if len(token) == 0 {
+			token, voip = session.VoIPPushToken(), true
 		}
-	} else {
-		for _, session := range user.Sessions {
-			idx := slices.IndexFunc(sockets, func(socketInfo SocketInfo) bool { return socketInfo.SessionID == session.SessionId })
-			if idx < 0 {
-				if session.OsType == grpcCWMPb.OS_TYPE_ANDROID {
-					if len(session.PushtokenID) > 0 {
-						androidSessions = append(androidSessions, session)
-					}
-				} else if session.OsType == grpcCWMPb.OS_TYPE_IOS {
-					if len(session.PushtokenID) > 0 {
-						iosSessions = append(androidSessions, session)
-					}
-				}
-			}
+		if len(token) == 0 {
+			continue
 		}
-	}
-
-	return androidSessions, iosSessions
-}
 
-func sendFCMMsg(offlineSessions []model.UserSession, dataBase64 string, priorityLevel appfirebase.FCM_PRIORITY) {
-	tokens := []string{}
-	for _, session := range offlineSessions {
-		tokens = append(tokens, session.PushtokenID)
+		pushTargets = append(pushTargets, apppush.PushTarget{
+			PhoneFull: user.PhoneFull,
+			SessionId: session.SessionId,
+			OsType:    session.OsType,
+			Token:     token,
+			VoIP:      voip,
+			BundleId:  session.BundleId,
+			Locale:    session.Locale,
+			P256dh:    session.WebPushP256dh,
+			Auth:      session.WebPushAuth,
+		})
 	}
 
-	appfirebase.GetAppFireBase().RequestSendMsg(&appfirebase.FCMSendMsg{
-		Tokens:        tokens,
-		DataBase64:    dataBase64,
-		PriorityLevel: priorityLevel,
-	})
-
+	return pushTargets
 }
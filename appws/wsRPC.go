@@ -0,0 +1,64 @@
+package appws
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	socketio "github.com/googollee/go-socket.io"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"sol.go/cwm/proto/grpcCWMPb"
+)
+
+// WSRPCHandler handles the payload of a WSRPCRequest and returns the serialized response msgs
+type WSRPCHandler func(ctx context.Context, wsCreds WsCreds, payload []byte) ([][]byte, error)
+
+// RegisterRPC registers a socket.io event which carries a base64 WSRPCRequest and acks with a base64 WSRPCResponse
+func (ws *WS) RegisterRPC(event string, handler WSRPCHandler) {
+	ws.Server.OnEvent("/", event, func(s socketio.Conn, data string) *string {
+		wsRPCRequest := &grpcCWMPb.WSRPCRequest{}
+
+		payload, err := base64.StdEncoding.DecodeString(data)
+		if err == nil {
+			err = proto.Unmarshal(payload, wsRPCRequest)
+		}
+
+		var payloads [][]byte
+		if err != nil {
+			log.Printf("socketio - on %v error: %v\n", event, err)
+			err = status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid request: %v", err))
+		} else {
+			wsCreds, ok := s.Context().(WsCreds)
+			if !ok || wsCreds.User == nil {
+				log.Printf("socketio - on %v error - can not cast WsCreds\n", event)
+				err = status.Errorf(codes.PermissionDenied, "Invalid WsCreds")
+			} else {
+				payloads, err = handler(context.Background(), wsCreds, wsRPCRequest.GetPayload())
+			}
+		}
+
+		wsRPCResponse := &grpcCWMPb.WSRPCResponse{
+			RequestId: wsRPCRequest.GetRequestId(),
+			Code:      int32(codes.OK),
+			Payloads:  payloads,
+		}
+		if err != nil {
+			st := status.Convert(err)
+			errMsg := st.Message()
+			wsRPCResponse.Code = int32(st.Code())
+			wsRPCResponse.Error = &errMsg
+			wsRPCResponse.Payloads = nil
+		}
+
+		res, err := proto.Marshal(wsRPCResponse)
+		if err != nil {
+			log.Printf("socketio - on %v error: %v\n", event, err)
+			return nil
+		}
+
+		result := base64.StdEncoding.EncodeToString(res)
+		return &result
+	})
+}
@@ -0,0 +1,103 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	BLOBSTORE_S3     = "s3"
+	BLOBSTORE_DISK   = "disk"
+	BLOBSTORE_MEMORY = "memory"
+
+	defaultDiskDir = "media"
+)
+
+var (
+	BlobNotFoundErr        = errors.New("Blob not found")
+	InvalidKeyErr          = errors.New("Invalid blob key")
+	InvalidRangeErr        = errors.New("Invalid blob range")
+	UploadNotFoundErr      = errors.New("Multipart upload not found")
+	PresignNotSupportedErr = errors.New("Presigned urls are not supported by the blob store")
+)
+
+type BlobInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+// BlobStore stores the media files. Multipart uploads are used by the resumable uploads,
+// every part except the last one has the same size
+type BlobStore interface {
+	Name() string
+
+	Put(ctx context.Context, key string, body io.Reader, contentType string) (*BlobInfo, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error)
+	// GetRange reads length bytes from offset, length < 0 - until the end of the blob
+	GetRange(ctx context.Context, key string, offset int64, length int64) (io.ReadCloser, *BlobInfo, error)
+	Stat(ctx context.Context, key string) (*BlobInfo, error)
+	Delete(ctx context.Context, key string) error
+	// PresignGet returns an url to download the blob directly from the storage
+	PresignGet(ctx context.Context, key string, expiry time.Duration) (string, error)
+
+	CreateMultipart(ctx context.Context, key string, contentType string) (string, error)
+	// UploadPart returns the ETag of the part
+	UploadPart(ctx context.Context, key string, uploadId string, partNumber int64, data []byte) (string, error)
+	// CompleteMultipart assembles the parts, partETags are the ETags of the parts by part number
+	CompleteMultipart(ctx context.Context, key string, uploadId string, partETags map[int64]string) (*BlobInfo, error)
+	AbortMultipart(ctx context.Context, key string, uploadId string) error
+}
+
+var (
+	singletonBlobStore BlobStore
+	onceBlobStore      sync.Once
+)
+
+// GetBlobStore selects the store from BLOBSTORE (s3|disk|memory, default s3), the disk store is in BLOBSTORE_DISK_DIR (default media)
+func GetBlobStore() BlobStore {
+	onceBlobStore.Do(func() {
+		fmt.Println("Init BlobStore...")
+
+		switch os.Getenv("BLOBSTORE") {
+		case BLOBSTORE_DISK:
+			dir := os.Getenv("BLOBSTORE_DISK_DIR")
+			if len(dir) == 0 {
+				dir = defaultDiskDir
+			}
+
+			diskBlobStore, err := NewDiskBlobStore(dir)
+			if err != nil {
+				log.Fatalf("Failed to init disk BlobStore: %v", err)
+			}
+			singletonBlobStore = diskBlobStore
+		case BLOBSTORE_MEMORY:
+			singletonBlobStore = NewMemoryBlobStore()
+		default:
+			singletonBlobStore = NewS3BlobStore()
+		}
+
+		log.Println("BlobStore:", singletonBlobStore.Name())
+	})
+	return singletonBlobStore
+}
+
+// checkRange resolves the length of a range read of a blob of size
+func checkRange(size int64, offset int64, length int64) (int64, error) {
+	if offset < 0 || offset > size || (offset == size && size > 0) {
+		return 0, fmt.Errorf("%w: %d of %d", InvalidRangeErr, offset, size)
+	}
+
+	if length < 0 || offset+length > size {
+		length = size - offset
+	}
+	return length, nil
+}
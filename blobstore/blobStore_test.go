@@ -0,0 +1,187 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// the stores without AWS must behave like the S3 one
+func testStores(t *testing.T) map[string]BlobStore {
+	diskBlobStore, err := NewDiskBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return map[string]BlobStore{
+		BLOBSTORE_MEMORY: NewMemoryBlobStore(),
+		BLOBSTORE_DISK:   diskBlobStore,
+	}
+}
+
+func readBlob(t *testing.T, body io.ReadCloser) string {
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestBlobStorePut(t *testing.T) {
+	ctx := context.Background()
+	for name, store := range testStores(t) {
+		_, err := store.Put(ctx, "cwm_ttl_blob.txt", strings.NewReader("0123456789"), "text/plain")
+		if err != nil {
+			t.Fatalf("%v: put error %v", name, err)
+		}
+
+		blobInfo, err := store.Stat(ctx, "cwm_ttl_blob.txt")
+		if err != nil {
+			t.Fatalf("%v: stat error %v", name, err)
+		}
+		if blobInfo.Size != 10 || len(blobInfo.ETag) == 0 {
+			t.Errorf("%v: stat = %+v", name, blobInfo)
+		}
+
+		body, _, err := store.Get(ctx, "cwm_ttl_blob.txt")
+		if err != nil {
+			t.Fatalf("%v: get error %v", name, err)
+		}
+		if got := readBlob(t, body); got != "0123456789" {
+			t.Errorf("%v: get = %q", name, got)
+		}
+
+		ranges := []struct {
+			offset int64
+			length int64
+			want   string
+		}{
+			{0, 4, "0123"},
+			{6, -1, "6789"},
+			{8, 10, "89"}, //a range past the end is truncated
+		}
+		for _, r := range ranges {
+			body, blobInfo, err := store.GetRange(ctx, "cwm_ttl_blob.txt", r.offset, r.length)
+			if err != nil {
+				t.Fatalf("%v: get range %v,%v error %v", name, r.offset, r.length, err)
+			}
+			if got := readBlob(t, body); got != r.want || blobInfo.Size != int64(len(r.want)) {
+				t.Errorf("%v: get range %v,%v = %q size %v, want %q", name, r.offset, r.length, got, blobInfo.Size, r.want)
+			}
+		}
+
+		_, _, err = store.GetRange(ctx, "cwm_ttl_blob.txt", 10, -1)
+		if !errors.Is(err, InvalidRangeErr) {
+			t.Errorf("%v: get range after the end error = %v", name, err)
+		}
+
+		err = store.Delete(ctx, "cwm_ttl_blob.txt")
+		if err != nil {
+			t.Fatalf("%v: delete error %v", name, err)
+		}
+		_, err = store.Stat(ctx, "cwm_ttl_blob.txt")
+		if !errors.Is(err, BlobNotFoundErr) {
+			t.Errorf("%v: stat of a deleted blob error = %v", name, err)
+		}
+		_, _, err = store.Get(ctx, "cwm_ttl_blob.txt")
+		if !errors.Is(err, BlobNotFoundErr) {
+			t.Errorf("%v: get of a deleted blob error = %v", name, err)
+		}
+
+		//deleting a missing blob is not an error, like on S3
+		err = store.Delete(ctx, "cwm_ttl_blob.txt")
+		if err != nil {
+			t.Errorf("%v: delete of a missing blob error = %v", name, err)
+		}
+	}
+}
+
+func TestBlobStoreMultipart(t *testing.T) {
+	ctx := context.Background()
+	parts := [][]byte{
+		bytes.Repeat([]byte("a"), 100),
+		bytes.Repeat([]byte("b"), 100),
+		[]byte("c"),
+	}
+
+	for name, store := range testStores(t) {
+		uploadId, err := store.CreateMultipart(ctx, "cwm_ttl_multipart.bin", "application/octet-stream")
+		if err != nil {
+			t.Fatalf("%v: create multipart error %v", name, err)
+		}
+
+		//nothing is visible until the upload is completed
+		_, err = store.Stat(ctx, "cwm_ttl_multipart.bin")
+		if !errors.Is(err, BlobNotFoundErr) {
+			t.Errorf("%v: stat before completion error = %v", name, err)
+		}
+
+		//the parts may be uploaded in any order
+		partETags := map[int64]string{}
+		for _, partNumber := range []int64{3, 1, 2} {
+			partETags[partNumber], err = store.UploadPart(ctx, "cwm_ttl_multipart.bin", uploadId, partNumber, parts[partNumber-1])
+			if err != nil {
+				t.Fatalf("%v: upload part %v error %v", name, partNumber, err)
+			}
+		}
+
+		blobInfo, err := store.CompleteMultipart(ctx, "cwm_ttl_multipart.bin", uploadId, partETags)
+		if err != nil {
+			t.Fatalf("%v: complete multipart error %v", name, err)
+		}
+		if blobInfo.Size != 201 {
+			t.Errorf("%v: completed size = %v", name, blobInfo.Size)
+		}
+
+		body, _, err := store.Get(ctx, "cwm_ttl_multipart.bin")
+		if err != nil {
+			t.Fatalf("%v: get error %v", name, err)
+		}
+		if got := readBlob(t, body); got != string(bytes.Join(parts, nil)) {
+			t.Errorf("%v: assembled blob = %q", name, got)
+		}
+
+		//a completed upload is gone
+		_, err = store.CompleteMultipart(ctx, "cwm_ttl_multipart.bin", uploadId, partETags)
+		if !errors.Is(err, UploadNotFoundErr) {
+			t.Errorf("%v: complete again error = %v", name, err)
+		}
+		_, err = store.UploadPart(ctx, "cwm_ttl_multipart.bin", uploadId, 4, []byte("d"))
+		if !errors.Is(err, UploadNotFoundErr) {
+			t.Errorf("%v: upload part after completion error = %v", name, err)
+		}
+	}
+}
+
+func TestBlobStoreAbortMultipart(t *testing.T) {
+	ctx := context.Background()
+	for name, store := range testStores(t) {
+		uploadId, err := store.CreateMultipart(ctx, "cwm_ttl_aborted.bin", "application/octet-stream")
+		if err != nil {
+			t.Fatalf("%v: create multipart error %v", name, err)
+		}
+
+		etag, err := store.UploadPart(ctx, "cwm_ttl_aborted.bin", uploadId, 1, []byte("data"))
+		if err != nil {
+			t.Fatalf("%v: upload part error %v", name, err)
+		}
+
+		err = store.AbortMultipart(ctx, "cwm_ttl_aborted.bin", uploadId)
+		if err != nil {
+			t.Fatalf("%v: abort multipart error %v", name, err)
+		}
+
+		_, err = store.CompleteMultipart(ctx, "cwm_ttl_aborted.bin", uploadId, map[int64]string{1: etag})
+		if !errors.Is(err, UploadNotFoundErr) {
+			t.Errorf("%v: complete after abort error = %v", name, err)
+		}
+		_, err = store.Stat(ctx, "cwm_ttl_aborted.bin")
+		if !errors.Is(err, BlobNotFoundErr) {
+			t.Errorf("%v: stat after abort error = %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"sol.go/cwm/utils"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	multipartDir       = ".multipart"
+	defaultContentType = "application/octet-stream"
+)
+
+// DiskBlobStore stores the blobs as files of a local folder, the parts of the multipart uploads are kept in root/.multipart/uploadId
+type DiskBlobStore struct {
+	root string
+}
+
+func NewDiskBlobStore(root string) (*DiskBlobStore, error) {
+	err := os.MkdirAll(filepath.Join(root, multipartDir), 0755)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create blob folder: %w", err)
+	}
+
+	return &DiskBlobStore{
+		root: root,
+	}, nil
+}
+
+func (store *DiskBlobStore) Name() string {
+	return BLOBSTORE_DISK
+}
+
+func (store *DiskBlobStore) Put(ctx context.Context, key string, body io.Reader, contentType string) (*BlobInfo, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.writeFile(path, func(file *os.File) error {
+		_, err := io.Copy(file, body)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return store.Stat(ctx, key)
+}
+
+func (store *DiskBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	return store.GetRange(ctx, key, 0, -1)
+}
+
+func (store *DiskBlobStore) GetRange(ctx context.Context, key string, offset int64, length int64) (io.ReadCloser, *BlobInfo, error) {
+	blobInfo, err := store.Stat(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	length, err = checkRange(blobInfo.Size, offset, length)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, _ := store.path(key)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, diskErr(err)
+	}
+
+	blobInfo.Size = length
+	return &sectionReadCloser{
+		Reader: io.NewSectionReader(file, offset, length),
+		Closer: file,
+	}, blobInfo, nil
+}
+
+func (store *DiskBlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return nil, diskErr(err)
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(key))
+	if len(contentType) == 0 {
+		contentType = defaultContentType
+	}
+
+	return &BlobInfo{
+		Key:          key,
+		Size:         fileInfo.Size(),
+		ContentType:  contentType,
+		ETag:         fmt.Sprintf("%x-%x", fileInfo.ModTime().UnixNano(), fileInfo.Size()),
+		LastModified: fileInfo.ModTime(),
+	}, nil
+}
+
+func (store *DiskBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("cannot delete file: %w", err)
+	}
+	return nil
+}
+
+func (store *DiskBlobStore) PresignGet(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	return "", PresignNotSupportedErr
+}
+
+func (store *DiskBlobStore) CreateMultipart(ctx context.Context, key string, contentType string) (string, error) {
+	_, err := store.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	uploadId := utils.GenerateUUID()
+	err = os.Mkdir(store.multipartPath(uploadId), 0755)
+	if err != nil {
+		return "", fmt.Errorf("cannot create multipart folder: %w", err)
+	}
+	return uploadId, nil
+}
+
+func (store *DiskBlobStore) UploadPart(ctx context.Context, key string, uploadId string, partNumber int64, data []byte) (string, error) {
+	uploadPath, err := store.uploadPath(uploadId)
+	if err != nil {
+		return "", err
+	}
+
+	err = store.writeFile(filepath.Join(uploadPath, strconv.FormatInt(partNumber, 10)), func(file *os.File) error {
+		_, err := file.Write(data)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", md5.Sum(data)), nil
+}
+
+func (store *DiskBlobStore) CompleteMultipart(ctx context.Context, key string, uploadId string, partETags map[int64]string) (*BlobInfo, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadPath, err := store.uploadPath(uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.writeFile(path, func(file *os.File) error {
+		for partNumber := int64(1); partNumber <= int64(len(partETags)); partNumber++ {
+			if _, ok := partETags[partNumber]; !ok {
+				return fmt.Errorf("missing part %d", partNumber)
+			}
+
+			part, err := os.Open(filepath.Join(uploadPath, strconv.FormatInt(partNumber, 10)))
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(file, part)
+			part.Close()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	os.RemoveAll(uploadPath)
+	return store.Stat(ctx, key)
+}
+
+func (store *DiskBlobStore) AbortMultipart(ctx context.Context, key string, uploadId string) error {
+	uploadPath, err := store.uploadPath(uploadId)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(uploadPath)
+}
+
+// writeFile writes a temp file then renames it, so a blob is never read half written
+func (store *DiskBlobStore) writeFile(path string, write func(file *os.File) error) error {
+	file, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("cannot create file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	err = write(file)
+	closeErr := file.Close()
+	if err != nil {
+		return fmt.Errorf("cannot write data to file: %w", err)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("cannot write data to file: %w", closeErr)
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		return fmt.Errorf("cannot rename file: %w", err)
+	}
+	return nil
+}
+
+func (store *DiskBlobStore) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", fmt.Errorf("%w: %s", InvalidKeyErr, key)
+	}
+	return filepath.Join(store.root, key), nil
+}
+
+func (store *DiskBlobStore) multipartPath(uploadId string) string {
+	return filepath.Join(store.root, multipartDir, uploadId)
+}
+
+func (store *DiskBlobStore) uploadPath(uploadId string) (string, error) {
+	if !validKey(uploadId) {
+		return "", fmt.Errorf("%w: %s", UploadNotFoundErr, uploadId)
+	}
+
+	uploadPath := store.multipartPath(uploadId)
+	_, err := os.Stat(uploadPath)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", UploadNotFoundErr, uploadId)
+	}
+	return uploadPath, nil
+}
+
+// validKey - the keys are flat file names
+func validKey(key string) bool {
+	return len(key) > 0 && !strings.HasPrefix(key, ".") && !strings.ContainsAny(key, `/\`)
+}
+
+func diskErr(err error) error {
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: %v", BlobNotFoundErr, err)
+	}
+	return err
+}
+
+type sectionReadCloser struct {
+	io.Reader
+	io.Closer
+}
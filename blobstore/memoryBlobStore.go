@@ -0,0 +1,169 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"sol.go/cwm/utils"
+	"sync"
+	"time"
+)
+
+type memoryBlob struct {
+	data []byte
+	info BlobInfo
+}
+
+// MemoryBlobStore keeps the blobs in memory, only meant for local runs
+type MemoryBlobStore struct {
+	mutex     sync.RWMutex
+	blobs     map[string]*memoryBlob
+	multipart map[string]map[int64][]byte
+}
+
+func NewMemoryBlobStore() *MemoryBlobStore {
+	return &MemoryBlobStore{
+		blobs:     make(map[string]*memoryBlob),
+		multipart: make(map[string]map[int64][]byte),
+	}
+}
+
+func (store *MemoryBlobStore) Name() string {
+	return BLOBSTORE_MEMORY
+}
+
+func (store *MemoryBlobStore) Put(ctx context.Context, key string, body io.Reader, contentType string) (*BlobInfo, error) {
+	if len(key) == 0 {
+		return nil, InvalidKeyErr
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read blob data: %w", err)
+	}
+	return store.put(key, data, contentType), nil
+}
+
+func (store *MemoryBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	return store.GetRange(ctx, key, 0, -1)
+}
+
+func (store *MemoryBlobStore) GetRange(ctx context.Context, key string, offset int64, length int64) (io.ReadCloser, *BlobInfo, error) {
+	store.mutex.RLock()
+	blob, ok := store.blobs[key]
+	store.mutex.RUnlock()
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s", BlobNotFoundErr, key)
+	}
+
+	length, err := checkRange(blob.info.Size, offset, length)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blobInfo := blob.info
+	blobInfo.Size = length
+	return io.NopCloser(bytes.NewReader(blob.data[offset : offset+length])), &blobInfo, nil
+}
+
+func (store *MemoryBlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	blob, ok := store.blobs[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", BlobNotFoundErr, key)
+	}
+
+	blobInfo := blob.info
+	return &blobInfo, nil
+}
+
+func (store *MemoryBlobStore) Delete(ctx context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delete(store.blobs, key)
+	return nil
+}
+
+func (store *MemoryBlobStore) PresignGet(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	return "", PresignNotSupportedErr
+}
+
+func (store *MemoryBlobStore) CreateMultipart(ctx context.Context, key string, contentType string) (string, error) {
+	if len(key) == 0 {
+		return "", InvalidKeyErr
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	uploadId := utils.GenerateUUID()
+	store.multipart[uploadId] = make(map[int64][]byte)
+	return uploadId, nil
+}
+
+func (store *MemoryBlobStore) UploadPart(ctx context.Context, key string, uploadId string, partNumber int64, data []byte) (string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	parts, ok := store.multipart[uploadId]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", UploadNotFoundErr, uploadId)
+	}
+
+	parts[partNumber] = append([]byte(nil), data...)
+	return fmt.Sprintf("%x", md5.Sum(data)), nil
+}
+
+func (store *MemoryBlobStore) CompleteMultipart(ctx context.Context, key string, uploadId string, partETags map[int64]string) (*BlobInfo, error) {
+	store.mutex.Lock()
+	parts, ok := store.multipart[uploadId]
+	delete(store.multipart, uploadId)
+	store.mutex.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", UploadNotFoundErr, uploadId)
+	}
+
+	data := bytes.Buffer{}
+	for partNumber := int64(1); partNumber <= int64(len(partETags)); partNumber++ {
+		part, ok := parts[partNumber]
+		if !ok {
+			return nil, fmt.Errorf("missing part %d", partNumber)
+		}
+		data.Write(part)
+	}
+
+	return store.put(key, data.Bytes(), defaultContentType), nil
+}
+
+func (store *MemoryBlobStore) AbortMultipart(ctx context.Context, key string, uploadId string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delete(store.multipart, uploadId)
+	return nil
+}
+
+func (store *MemoryBlobStore) put(key string, data []byte, contentType string) *BlobInfo {
+	blob := &memoryBlob{
+		data: data,
+		info: BlobInfo{
+			Key:          key,
+			Size:         int64(len(data)),
+			ContentType:  contentType,
+			ETag:         fmt.Sprintf("%x", md5.Sum(data)),
+			LastModified: time.Now(),
+		},
+	}
+
+	store.mutex.Lock()
+	store.blobs[key] = blob
+	store.mutex.Unlock()
+
+	blobInfo := blob.info
+	return &blobInfo
+}
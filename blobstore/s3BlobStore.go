@@ -0,0 +1,161 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"io"
+	"sol.go/cwm/s3Handler"
+	"time"
+)
+
+// S3BlobStore stores the blobs in the S3_BUCKET
+type S3BlobStore struct {
+	FileStore *s3Handler.S3FileStore
+	client    *s3.S3
+}
+
+func NewS3BlobStore() *S3BlobStore {
+	fileStore := s3Handler.GetS3FileStore()
+	return &S3BlobStore{
+		FileStore: fileStore,
+		client:    s3.New(fileStore.Session),
+	}
+}
+
+func (store *S3BlobStore) Name() string {
+	return BLOBSTORE_S3
+}
+
+func (store *S3BlobStore) Put(ctx context.Context, key string, body io.Reader, contentType string) (*BlobInfo, error) {
+	uploadOutput, err := store.FileStore.UploadFile(ctx, key, body, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlobInfo{
+		Key:          key,
+		ContentType:  contentType,
+		ETag:         aws.StringValue(uploadOutput.ETag),
+		LastModified: time.Now(),
+	}, nil
+}
+
+func (store *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	return store.GetRange(ctx, key, 0, -1)
+}
+
+func (store *S3BlobStore) GetRange(ctx context.Context, key string, offset int64, length int64) (io.ReadCloser, *BlobInfo, error) {
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(store.FileStore.Bucket),
+		Key:    aws.String(key),
+	}
+
+	if offset > 0 || length >= 0 {
+		blobInfo, err := store.Stat(ctx, key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		length, err = checkRange(blobInfo.Size, offset, length)
+		if err != nil {
+			return nil, nil, err
+		}
+		if length == 0 {
+			return io.NopCloser(bytes.NewReader(nil)), blobInfo, nil
+		}
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	}
+
+	s3Object, err := store.client.GetObjectWithContext(ctx, input)
+	if err != nil {
+		return nil, nil, s3Err(err)
+	}
+
+	return s3Object.Body, &BlobInfo{
+		Key:          key,
+		Size:         aws.Int64Value(s3Object.ContentLength),
+		ContentType:  aws.StringValue(s3Object.ContentType),
+		ETag:         aws.StringValue(s3Object.ETag),
+		LastModified: aws.TimeValue(s3Object.LastModified),
+	}, nil
+}
+
+func (store *S3BlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	output, err := store.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(store.FileStore.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, s3Err(err)
+	}
+
+	return &BlobInfo{
+		Key:          key,
+		Size:         aws.Int64Value(output.ContentLength),
+		ContentType:  aws.StringValue(output.ContentType),
+		ETag:         aws.StringValue(output.ETag),
+		LastModified: aws.TimeValue(output.LastModified),
+	}, nil
+}
+
+func (store *S3BlobStore) Delete(ctx context.Context, key string) error {
+	return store.FileStore.DeleteObject(ctx, key)
+}
+
+func (store *S3BlobStore) PresignGet(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	req, _ := store.client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(store.FileStore.Bucket),
+		Key:    aws.String(key),
+	})
+	return req.Presign(expiry)
+}
+
+func (store *S3BlobStore) CreateMultipart(ctx context.Context, key string, contentType string) (string, error) {
+	return store.FileStore.CreateMultipartUpload(ctx, key, contentType)
+}
+
+func (store *S3BlobStore) UploadPart(ctx context.Context, key string, uploadId string, partNumber int64, data []byte) (string, error) {
+	etag, err := store.FileStore.UploadPart(ctx, key, uploadId, partNumber, data)
+	if err != nil {
+		return "", s3Err(err)
+	}
+	return etag, nil
+}
+
+func (store *S3BlobStore) CompleteMultipart(ctx context.Context, key string, uploadId string, partETags map[int64]string) (*BlobInfo, error) {
+	output, err := store.FileStore.CompleteMultipartUpload(ctx, key, uploadId, partETags)
+	if err != nil {
+		return nil, s3Err(err)
+	}
+
+	return &BlobInfo{
+		Key:          key,
+		ETag:         aws.StringValue(output.ETag),
+		LastModified: time.Now(),
+	}, nil
+}
+
+func (store *S3BlobStore) AbortMultipart(ctx context.Context, key string, uploadId string) error {
+	return s3Err(store.FileStore.AbortMultipartUpload(ctx, key, uploadId))
+}
+
+// s3Err maps the missing object / upload errors of S3
+func s3Err(err error) error {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return err
+	}
+
+	switch awsErr.Code() {
+	case s3.ErrCodeNoSuchKey, "NotFound":
+		return fmt.Errorf("%w: %v", BlobNotFoundErr, err)
+	case s3.ErrCodeNoSuchUpload:
+		return fmt.Errorf("%w: %v", UploadNotFoundErr, err)
+	}
+	return err
+}
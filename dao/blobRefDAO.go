@@ -0,0 +1,210 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"log"
+	"sol.go/cwm/model"
+	"sync"
+	"time"
+)
+
+type BlobRefDAO struct {
+	DAO
+}
+
+var singletonBlobRefDAO *BlobRefDAO
+var onceBlobRefDAO sync.Once
+
+func GetBlobRefDAO() *BlobRefDAO {
+	onceBlobRefDAO.Do(func() {
+		fmt.Println("Init BlobRefDAO...")
+
+		db := GetDataBase()
+		mongoCtx, cancelMongo := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancelMongo()
+
+		blobRefDAO := BlobRefDAO{}
+		blobRefDAO.Init(mongoCtx, &db.MongoDb)
+
+		singletonBlobRefDAO = &blobRefDAO
+	})
+	return singletonBlobRefDAO
+}
+
+func (blobRefDAO *BlobRefDAO) Init(ctx context.Context, db *mongo.Database) {
+	COLLECTION_NAME := "blobRefs"
+	CACHE_TTL := 10 * time.Minute
+	CACHE_LOCK_TTL := 30 * time.Second
+	blobRefDAO.InitDAO(ctx, db, COLLECTION_NAME, []string{}, CACHE_TTL, CACHE_LOCK_TTL)
+
+	//the blobs are found by the checksum declared by the client
+	_, err := blobRefDAO.Collection.Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys: bson.D{{Key: "checksum", Value: 1}, {Key: "fileSize", Value: 1}},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = blobRefDAO.Collection.Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys: bson.D{{Key: "originalChecksum", Value: 1}, {Key: "originalFileSize", Value: 1}},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (blobRefDAO *BlobRefDAO) FindByBlobKey(ctx context.Context, blobKey string) (*model.BlobRef, error) {
+	result := &model.BlobRef{}
+
+	err := blobRefDAO.FindByPKey(ctx, blobKey, result)
+	if err != nil {
+		return nil, fmt.Errorf("(BlobRefDAO - FindByBlobKey): failed executing FindByPKey -> %w", err)
+	}
+
+	return result, nil
+}
+
+// IncreaseByContent adds a reference to the blob of the content, only if it is still referenced.
+// The content is the stored one or the one received before the sanitization
+func (blobRefDAO *BlobRefDAO) IncreaseByContent(ctx context.Context, checksum string, fileSize int64) (*model.BlobRef, error) {
+	filter := primitive.M{
+		"$or": []primitive.M{
+			{"checksum": checksum, "fileSize": fileSize},
+			{"originalChecksum": checksum, "originalFileSize": fileSize},
+		},
+		"refCount": primitive.M{"$gt": 0},
+	}
+	update := primitive.M{
+		"$inc": primitive.M{"refCount": 1},
+		"$set": primitive.M{"lastModified": time.Now().UnixMilli()},
+	}
+
+	result := &model.BlobRef{}
+	err := blobRefDAO.Collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(result)
+	if err != nil {
+		return nil, fmt.Errorf("(BlobRefDAO - IncreaseByContent): failed executing FindOneAndUpdate -> %w", err)
+	}
+
+	return result, nil
+}
+
+// IncreaseByBlobKey adds a reference to the blob, the BlobRef is created for a new blob
+func (blobRefDAO *BlobRefDAO) IncreaseByBlobKey(ctx context.Context, blobRef *model.BlobRef) (*model.BlobRef, error) {
+	now := time.Now().UnixMilli()
+	update := primitive.M{
+		"$inc": primitive.M{"refCount": 1},
+		"$set": primitive.M{"lastModified": now},
+		"$setOnInsert": primitive.M{
+			"checksum":         blobRef.Checksum,
+			"fileSize":         blobRef.FileSize,
+			"mimeType":         blobRef.MimeType,
+			"originalChecksum": blobRef.OriginalChecksum,
+			"originalFileSize": blobRef.OriginalFileSize,
+			"createdAt":        now,
+		},
+	}
+
+	result := &model.BlobRef{}
+	err := blobRefDAO.UpdateByPKey(ctx, blobRef.BlobKey, update, nil, true, result)
+	if err != nil {
+		return nil, fmt.Errorf("(BlobRefDAO - IncreaseByBlobKey): failed executing UpdateByPKey -> %w", err)
+	}
+
+	return result, nil
+}
+
+func (blobRefDAO *BlobRefDAO) DecreaseByBlobKey(ctx context.Context, blobKey string) (*model.BlobRef, error) {
+	update := primitive.M{
+		"$inc": primitive.M{"refCount": -1},
+		"$set": primitive.M{"lastModified": time.Now().UnixMilli()},
+	}
+
+	result := &model.BlobRef{}
+	err := blobRefDAO.UpdateByPKey(ctx, blobKey, update, nil, false, result)
+	if err != nil {
+		return nil, fmt.Errorf("(BlobRefDAO - DecreaseByBlobKey): failed executing UpdateByPKey -> %w", err)
+	}
+
+	return result, nil
+}
+
+func (blobRefDAO *BlobRefDAO) SetRefCount(ctx context.Context, blobKey string, refCount int64) error {
+	update := primitive.M{
+		"$set": primitive.M{
+			"refCount":     refCount,
+			"lastModified": time.Now().UnixMilli(),
+		},
+	}
+
+	result := &model.BlobRef{}
+	err := blobRefDAO.UpdateByPKey(ctx, blobKey, update, nil, false, result)
+	if err != nil {
+		return fmt.Errorf("(BlobRefDAO - SetRefCount): failed executing UpdateByPKey -> %w", err)
+	}
+
+	return nil
+}
+
+// DeleteUnreferenced deletes the BlobRef only if no reference was added in the meantime
+func (blobRefDAO *BlobRefDAO) DeleteUnreferenced(ctx context.Context, blobKey string) (bool, error) {
+	filter := primitive.M{
+		PKEY_NAME:  blobKey,
+		"refCount": primitive.M{"$lte": 0},
+	}
+
+	result, err := blobRefDAO.Collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return false, fmt.Errorf("(BlobRefDAO - DeleteUnreferenced): failed executing DeleteOne -> %w", err)
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+func (blobRefDAO *BlobRefDAO) SetVariants(ctx context.Context, blobKey string, variants []model.FileVariant) (*model.BlobRef, error) {
+	update := primitive.M{
+		"$set": primitive.M{
+			"variants":     variants,
+			"lastModified": time.Now().UnixMilli(),
+		},
+	}
+
+	result := &model.BlobRef{}
+	err := blobRefDAO.UpdateByPKey(ctx, blobKey, update, nil, false, result)
+	if err != nil {
+		return nil, fmt.Errorf("(BlobRefDAO - SetVariants): failed executing UpdateByPKey -> %w", err)
+	}
+
+	return result, nil
+}
+
+func (blobRefDAO *BlobRefDAO) SetScanResult(ctx context.Context, blobKey string, scanStatus string, blockedReason string, quarantineKey string) (*model.BlobRef, error) {
+	update := primitive.M{
+		"$set": primitive.M{
+			"scanStatus":    scanStatus,
+			"blockedReason": blockedReason,
+			"quarantineKey": quarantineKey,
+			"lastModified":  time.Now().UnixMilli(),
+		},
+	}
+
+	result := &model.BlobRef{}
+	err := blobRefDAO.UpdateByPKey(ctx, blobKey, update, nil, false, result)
+	if err != nil {
+		return nil, fmt.Errorf("(BlobRefDAO - SetScanResult): failed executing UpdateByPKey -> %w", err)
+	}
+
+	return result, nil
+}
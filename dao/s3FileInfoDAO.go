@@ -3,7 +3,11 @@ package dao
 import (
 	"context"
 	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"log"
 	"sol.go/cwm/model"
 	"sync"
 	"time"
@@ -37,6 +41,28 @@ func (s3FileInfoDAO *S3FileInfoDAO) Init(ctx context.Context, db *mongo.Database
 	CACHE_TTL := 10 * time.Minute
 	CACHE_LOCK_TTL := 30 * time.Second
 	s3FileInfoDAO.InitDAO(ctx, db, COLLECTION_NAME, []string{}, CACHE_TTL, CACHE_LOCK_TTL)
+
+	//the blobs are shared by the S3FileInfo of the same content
+	_, err := s3FileInfoDAO.Collection.Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys: bson.D{{Key: "fileName", Value: 1}},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//the pending scans are swept
+	_, err = s3FileInfoDAO.Collection.Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys: bson.D{{Key: "scanStatus", Value: 1}, {Key: "_id", Value: 1}},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
 
 func (s3FileInfoDAO *S3FileInfoDAO) Save(ctx context.Context, s3FileInfo *model.S3FileInfo) (*model.S3FileInfo, error) {
@@ -68,3 +94,88 @@ func (s3FileInfoDAO *S3FileInfoDAO) DeleteByFileId(ctx context.Context, fileId s
 	}
 	return result, nil
 }
+
+func (s3FileInfoDAO *S3FileInfoDAO) CountByFileName(ctx context.Context, fileName string) (int64, error) {
+	count, err := s3FileInfoDAO.CountDocuments(ctx, primitive.M{"fileName": fileName})
+	if err != nil {
+		return 0, fmt.Errorf("(S3FileInfoDAO - CountByFileName): failed executing CountDocuments -> %w", err)
+	}
+
+	return count, nil
+}
+
+func (s3FileInfoDAO *S3FileInfoDAO) SetVariants(ctx context.Context, fileId string, variants []model.FileVariant) (*model.S3FileInfo, error) {
+	update := primitive.M{
+		"$set": primitive.M{"variants": variants},
+	}
+
+	result := &model.S3FileInfo{}
+	err := s3FileInfoDAO.UpdateByPKey(ctx, fileId, update, nil, false, result)
+	if err != nil {
+		return nil, fmt.Errorf("(S3FileInfoDAO - SetVariants): failed executing UpdateByPKey -> %w", err)
+	}
+
+	return result, nil
+}
+
+// SetScanResultByFileName updates the S3FileInfos of the blob, the result of a scan applies to every copy of the content
+func (s3FileInfoDAO *S3FileInfoDAO) SetScanResultByFileName(ctx context.Context, fileName string, scanStatus string, blockedReason string) (int64, error) {
+	update := primitive.M{
+		"$set": primitive.M{
+			"scanStatus":    scanStatus,
+			"blockedReason": blockedReason,
+		},
+	}
+
+	count, err := s3FileInfoDAO.UpdateMany(ctx, primitive.M{"fileName": fileName}, update)
+	if err != nil {
+		return 0, fmt.Errorf("(S3FileInfoDAO - SetScanResultByFileName): failed executing UpdateMany -> %w", err)
+	}
+
+	return count, nil
+}
+
+// FindAllAfter pages through all the S3FileInfos by _id, lastId is the _id of the last S3FileInfo of the previous page
+func (s3FileInfoDAO *S3FileInfoDAO) FindAllAfter(ctx context.Context, lastId primitive.ObjectID, limit int64) ([]*model.S3FileInfo, error) {
+	results := []*model.S3FileInfo{}
+	filter := primitive.M{}
+	if !lastId.IsZero() {
+		filter["_id"] = primitive.M{"$gt": lastId}
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(primitive.M{"_id": 1})
+	findOptions.SetLimit(limit)
+
+	err := s3FileInfoDAO.FindAll(ctx, filter, findOptions, &results)
+	if err != nil {
+		return nil, fmt.Errorf("(S3FileInfoDAO - FindAllAfter): failed executing FindAll -> %w", err)
+	}
+
+	return results, nil
+}
+
+// FindAllPendingScanAfter pages by _id through the S3FileInfos still waiting for their scan, created before createdBefore
+func (s3FileInfoDAO *S3FileInfoDAO) FindAllPendingScanAfter(ctx context.Context, lastId primitive.ObjectID, createdBefore int64, limit int64) ([]*model.S3FileInfo, error) {
+	results := []*model.S3FileInfo{}
+	filter := primitive.M{
+		"scanStatus": model.SCAN_STATUS_PENDING,
+		"createdAt": primitive.M{
+			"$lt": createdBefore,
+		},
+	}
+	if !lastId.IsZero() {
+		filter["_id"] = primitive.M{"$gt": lastId}
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(primitive.M{"_id": 1})
+	findOptions.SetLimit(limit)
+
+	err := s3FileInfoDAO.FindAll(ctx, filter, findOptions, &results)
+	if err != nil {
+		return nil, fmt.Errorf("(S3FileInfoDAO - FindAllPendingScanAfter): failed executing FindAll -> %w", err)
+	}
+
+	return results, nil
+}
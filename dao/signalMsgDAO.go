@@ -7,6 +7,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
 	"sync"
 	"time"
 )
@@ -364,6 +365,129 @@ func (signalMsgDAO *SignalMsgDAO) FindOldMsgOfThread(ctx context.Context,
 	return results, nil
 }
 
+func (signalMsgDAO *SignalMsgDAO) UnreadOfThreadFilter(phoneFull string, threadId string) primitive.M {
+	/*
+		{
+			"threadId": threadId,
+			"from": {
+				$ne: phoneFull
+			},
+			"imType": {
+				$gt: SIGNAL_IM_TYPE_EVENT
+			},
+			"seenByUsers": {
+				$nin: []string{phoneFull}
+			},
+			"deleteForUsers": {
+				$nin: []string{phoneFull}
+			},
+		}
+	*/
+
+	filter := primitive.M{
+		"threadId": threadId,
+		"from": primitive.M{
+			"$ne": phoneFull,
+		},
+		"imType": primitive.M{
+			"$gt": cwmSignalMsgPb.SIGNAL_IM_TYPE_EVENT,
+		},
+		"seenByUsers": primitive.M{
+			"$nin": []string{phoneFull},
+		},
+		"deleteForUsers": primitive.M{
+			"$nin": []string{phoneFull},
+		},
+	}
+
+	return filter
+}
+
+// CountUnreadOfThread counts the unread msgs of the user in the thread, which are created until createdUntil
+func (signalMsgDAO *SignalMsgDAO) CountUnreadOfThread(ctx context.Context, phoneFull string, threadId string, createdUntil int64) (int64, error) {
+	filter := signalMsgDAO.UnreadOfThreadFilter(phoneFull, threadId)
+	filter["createdAt"] = primitive.M{
+		"$lte": createdUntil,
+	}
+
+	return signalMsgDAO.CountDocuments(ctx, filter)
+}
+
+// FindAllWithoutImType returns the msgs saved before imType was stored, in the order of _id after afterOid
+func (signalMsgDAO *SignalMsgDAO) FindAllWithoutImType(ctx context.Context, afterOid primitive.ObjectID, limit int64) ([]*model.SignalMsg, error) {
+	results := []*model.SignalMsg{}
+	filter := primitive.M{
+		"_id": primitive.M{
+			"$gt": afterOid,
+		},
+		"imType": primitive.M{
+			"$exists": false,
+		},
+	}
+
+	findOptions := options.Find().
+		SetSort(primitive.M{"_id": 1}).
+		SetLimit(limit)
+
+	err := signalMsgDAO.FindAll(ctx, filter, findOptions, &results)
+	if err != nil {
+		return nil, fmt.Errorf("(SignalMsgDAO - FindAllWithoutImType): failed executing FindAll -> %w", err)
+	}
+
+	return results, nil
+}
+
+// FindAllMediaMsgsAfter pages by _id through the msgs which can have shared media: MULTIMEDIA, URL and FORWARD,
+// or saved before imType was stored
+func (signalMsgDAO *SignalMsgDAO) FindAllMediaMsgsAfter(ctx context.Context, afterOid primitive.ObjectID, limit int64) ([]*model.SignalMsg, error) {
+	results := []*model.SignalMsg{}
+	filter := primitive.M{
+		"_id": primitive.M{
+			"$gt": afterOid,
+		},
+		"$or": []primitive.M{
+			{"imType": primitive.M{"$in": []cwmSignalMsgPb.SIGNAL_IM_TYPE{
+				cwmSignalMsgPb.SIGNAL_IM_TYPE_MULTIMEDIA,
+				cwmSignalMsgPb.SIGNAL_IM_TYPE_URL,
+				cwmSignalMsgPb.SIGNAL_IM_TYPE_FORWARD,
+			}}},
+			{"imType": primitive.M{"$exists": false}},
+		},
+	}
+
+	findOptions := options.Find().
+		SetSort(primitive.M{"_id": 1}).
+		SetLimit(limit)
+
+	err := signalMsgDAO.FindAll(ctx, filter, findOptions, &results)
+	if err != nil {
+		return nil, fmt.Errorf("(SignalMsgDAO - FindAllMediaMsgsAfter): failed executing FindAll -> %w", err)
+	}
+
+	return results, nil
+}
+
+// SetImTypes sets the imType of the msgs, by msgId
+func (signalMsgDAO *SignalMsgDAO) SetImTypes(ctx context.Context, imTypes map[string]cwmSignalMsgPb.SIGNAL_IM_TYPE) error {
+	if len(imTypes) == 0 {
+		return nil
+	}
+
+	models := []mongo.WriteModel{}
+	for msgId, imType := range imTypes {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(primitive.M{PKEY_NAME: msgId}).
+			SetUpdate(primitive.M{"$set": primitive.M{"imType": imType}}))
+	}
+
+	_, err := signalMsgDAO.Collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return fmt.Errorf("(SignalMsgDAO - SetImTypes): failed executing BulkWrite -> %w", err)
+	}
+
+	return nil
+}
+
 func (signalMsgDAO *SignalMsgDAO) AppendReceivedSessionByMsgIds(ctx context.Context, msgIds []string, sessionId string) (int64, error) {
 	//https://www.mongodb.com/docs/manual/reference/operator/update/addToSet/
 	filter := primitive.M{
@@ -379,6 +503,21 @@ func (signalMsgDAO *SignalMsgDAO) AppendReceivedSessionByMsgIds(ctx context.Cont
 	return signalMsgDAO.UpdateMany(ctx, filter, update)
 }
 
+func (signalMsgDAO *SignalMsgDAO) AppendPushedSessionsByMsgId(ctx context.Context, msgId string, sessionIds []string) (int64, error) {
+	//https://www.mongodb.com/docs/manual/reference/operator/update/addToSet/
+	filter := primitive.M{
+		"pkey": msgId,
+	}
+
+	updateFields := primitive.M{}
+	updateFields["pushedSessions"] = primitive.M{
+		"$each": sessionIds,
+	}
+	update := primitive.M{"$addToSet": updateFields}
+
+	return signalMsgDAO.UpdateMany(ctx, filter, update)
+}
+
 func (signalMsgDAO *SignalMsgDAO) FindAllByMsgIds(ctx context.Context, msgIds []string) ([]*model.SignalMsg, error) {
 	results := []*model.SignalMsg{}
 	filter := primitive.M{
@@ -119,17 +119,30 @@ func (signalThreadDAO *SignalThreadDAO) UpdateParticipants(ctx context.Context,
 		updateFields["allParticipants"] = allParticipants
 	}
 
+	now := time.Now().UnixMilli()
 	if len(participantRemoves) > 0 {
+		removedParticipants := signalThread.RemovedParticipants
 		for _, participant := range participantRemoves {
 			idx := slices.IndexFunc(participants, func(p string) bool { return p == participant })
 			if idx >= 0 {
 				participants = slices.Delete(participants, idx, idx+1)
+
+				//the removed members keep access to the msgs sent before their removal
+				idx = slices.IndexFunc(removedParticipants, func(r model.RemovedParticipant) bool { return r.PhoneFull == participant })
+				if idx >= 0 {
+					removedParticipants = slices.Delete(removedParticipants, idx, idx+1)
+				}
+				removedParticipants = append(removedParticipants, model.RemovedParticipant{
+					PhoneFull: participant,
+					RemovedAt: now,
+				})
 			}
 		}
+		updateFields["removedParticipants"] = removedParticipants
 	}
 
 	updateFields["participants"] = participants
-	updateFields["lastModified"] = time.Now().UnixMilli()
+	updateFields["lastModified"] = now
 	update := primitive.M{"$set": updateFields}
 
 	return signalThreadDAO.UpdateByThreadId(ctx, threadId, update, []interface{}{}, false)
@@ -0,0 +1,114 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"sol.go/cwm/model"
+	"sync"
+	"time"
+)
+
+type StorageUsageDAO struct {
+	DAO
+}
+
+var singletonStorageUsageDAO *StorageUsageDAO
+var onceStorageUsageDAO sync.Once
+
+func GetStorageUsageDAO() *StorageUsageDAO {
+	onceStorageUsageDAO.Do(func() {
+		fmt.Println("Init StorageUsageDAO...")
+
+		db := GetDataBase()
+		mongoCtx, cancelMongo := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancelMongo()
+
+		storageUsageDAO := StorageUsageDAO{}
+		storageUsageDAO.Init(mongoCtx, &db.MongoDb)
+
+		singletonStorageUsageDAO = &storageUsageDAO
+	})
+	return singletonStorageUsageDAO
+}
+
+func (storageUsageDAO *StorageUsageDAO) Init(ctx context.Context, db *mongo.Database) {
+	COLLECTION_NAME := "storageUsages"
+	CACHE_TTL := 10 * time.Minute
+	CACHE_LOCK_TTL := 30 * time.Second
+	storageUsageDAO.InitDAO(ctx, db, COLLECTION_NAME, []string{}, CACHE_TTL, CACHE_LOCK_TTL)
+}
+
+func (storageUsageDAO *StorageUsageDAO) FindByPhoneFull(ctx context.Context, phoneFull string) (*model.StorageUsage, error) {
+	result := &model.StorageUsage{}
+
+	err := storageUsageDAO.FindByPKey(ctx, phoneFull, result)
+	if err != nil {
+		return nil, fmt.Errorf("(StorageUsageDAO - FindByPhoneFull): failed executing FindByPKey -> %w", err)
+	}
+
+	return result, nil
+}
+
+// IncreaseStoredBytes adds a file to the usage of the user, only if the usage stays within maxStoredBytes (0 - unlimited).
+// Returns mongo.ErrNoDocuments if the file does not fit
+func (storageUsageDAO *StorageUsageDAO) IncreaseStoredBytes(ctx context.Context, phoneFull string, fileSize int64, maxStoredBytes int64) (*model.StorageUsage, error) {
+	now := time.Now().UnixMilli()
+
+	//the usage is created by the first upload of the user
+	create := primitive.M{
+		"$setOnInsert": primitive.M{
+			"storedBytes": 0,
+			"fileCount":   0,
+			"createdAt":   now,
+		},
+	}
+	result := &model.StorageUsage{}
+	err := storageUsageDAO.UpdateByPKey(ctx, phoneFull, create, nil, true, result)
+	if err != nil {
+		return nil, fmt.Errorf("(StorageUsageDAO - IncreaseStoredBytes): failed executing UpdateByPKey -> %w", err)
+	}
+
+	filter := primitive.M{PKEY_NAME: phoneFull}
+	if maxStoredBytes > 0 {
+		filter["storedBytes"] = primitive.M{"$lte": maxStoredBytes - fileSize}
+	}
+	update := primitive.M{
+		"$inc": primitive.M{"storedBytes": fileSize, "fileCount": 1},
+		"$set": primitive.M{"lastModified": now},
+	}
+
+	err = storageUsageDAO.Collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(result)
+	if err != nil {
+		return nil, fmt.Errorf("(StorageUsageDAO - IncreaseStoredBytes): failed executing FindOneAndUpdate -> %w", err)
+	}
+
+	return result, nil
+}
+
+// DecreaseStoredBytes removes a file from the usage of the user, the usage never goes below 0:
+// the files uploaded before the usages were tracked are not counted
+func (storageUsageDAO *StorageUsageDAO) DecreaseStoredBytes(ctx context.Context, phoneFull string, fileSize int64) (*model.StorageUsage, error) {
+	filter := primitive.M{PKEY_NAME: phoneFull}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: primitive.M{
+			"storedBytes":  primitive.M{"$max": primitive.A{0, primitive.M{"$subtract": primitive.A{"$storedBytes", fileSize}}}},
+			"fileCount":    primitive.M{"$max": primitive.A{0, primitive.M{"$subtract": primitive.A{"$fileCount", 1}}}},
+			"lastModified": time.Now().UnixMilli(),
+		}}},
+	}
+
+	result := &model.StorageUsage{}
+	err := storageUsageDAO.Collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(result)
+	if err != nil {
+		return nil, fmt.Errorf("(StorageUsageDAO - DecreaseStoredBytes): failed executing FindOneAndUpdate -> %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,187 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"log"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"sync"
+	"time"
+)
+
+type ThreadMediaDAO struct {
+	DAO
+}
+
+var singletonThreadMediaDAO *ThreadMediaDAO
+var onceThreadMediaDAO sync.Once
+
+func GetThreadMediaDAO() *ThreadMediaDAO {
+	onceThreadMediaDAO.Do(func() {
+		fmt.Println("Init ThreadMediaDAO...")
+
+		db := GetDataBase()
+		mongoCtx, cancelMongo := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancelMongo()
+
+		threadMediaDAO := ThreadMediaDAO{}
+		threadMediaDAO.Init(mongoCtx, &db.MongoDb)
+
+		singletonThreadMediaDAO = &threadMediaDAO
+	})
+	return singletonThreadMediaDAO
+}
+
+func (threadMediaDAO *ThreadMediaDAO) Init(ctx context.Context, db *mongo.Database) {
+	COLLECTION_NAME := "threadMedias"
+	CACHE_TTL := 10 * time.Minute
+	CACHE_LOCK_TTL := 30 * time.Second
+	threadMediaDAO.InitDAO(ctx, db, COLLECTION_NAME, []string{}, CACHE_TTL, CACHE_LOCK_TTL)
+
+	//the pages of a tab of a thread, newest first
+	_, err := threadMediaDAO.Collection.Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "threadId", Value: 1},
+				{Key: "mediaType", Value: 1},
+				{Key: "createdAt", Value: -1},
+				{Key: "_id", Value: -1},
+			},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	//the deletions of the msgs are copied by msgId
+	_, err = threadMediaDAO.Collection.Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys: bson.D{{Key: "msgId", Value: 1}},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (threadMediaDAO *ThreadMediaDAO) Save(ctx context.Context, threadMedia *model.ThreadMedia) (*model.ThreadMedia, error) {
+	result := &model.ThreadMedia{}
+	err := threadMediaDAO.InsertOrUpdate(ctx, threadMedia, result)
+	if err != nil {
+		return nil, fmt.Errorf("(ThreadMediaDAO - Save): failed executing Save -> %w", err)
+	}
+
+	return result, nil
+}
+
+// InsertIfMissing inserts the media unless it is indexed already, an indexed media keeps its deletions
+func (threadMediaDAO *ThreadMediaDAO) InsertIfMissing(ctx context.Context, threadMedia *model.ThreadMedia) error {
+	filter := primitive.M{PKEY_NAME: threadMedia.MediaKey}
+	update := primitive.M{"$setOnInsert": threadMedia}
+
+	_, err := threadMediaDAO.Collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("(ThreadMediaDAO - InsertIfMissing): failed executing UpdateOne -> %w", err)
+	}
+	return nil
+}
+
+// FindPageOfThread returns the medias of the thread not deleted by the user, newest first.
+// maxCreatedAt limits a former participant to the msgs sent before the removal (0 - no limit),
+// the page starts after the media (cursorCreatedAt, cursorId) of the previous page
+func (threadMediaDAO *ThreadMediaDAO) FindPageOfThread(ctx context.Context, phoneFull string, threadId string, mediaType grpcCWMPb.THREAD_MEDIA_TYPE,
+	maxCreatedAt int64, cursorCreatedAt int64, cursorId primitive.ObjectID, limit int64) ([]*model.ThreadMedia, error) {
+
+	filterAnds := []interface{}{
+		primitive.M{"threadId": threadId},
+		primitive.M{"mediaType": mediaType},
+		primitive.M{
+			"deleteForUsers": primitive.M{
+				"$nin": []string{phoneFull},
+			},
+		},
+	}
+	if maxCreatedAt > 0 {
+		filterAnds = append(filterAnds, primitive.M{
+			"createdAt": primitive.M{"$lte": maxCreatedAt},
+		})
+	}
+	if !cursorId.IsZero() {
+		filterAnds = append(filterAnds, primitive.M{
+			"$or": []primitive.M{
+				{"createdAt": primitive.M{"$lt": cursorCreatedAt}},
+				{"createdAt": cursorCreatedAt, "_id": primitive.M{"$lt": cursorId}},
+			},
+		})
+	}
+	filter := primitive.M{"$and": filterAnds}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}})
+	findOptions.SetLimit(limit)
+
+	results := []*model.ThreadMedia{}
+	err := threadMediaDAO.FindAll(ctx, filter, findOptions, &results)
+	if err != nil {
+		return nil, fmt.Errorf("(ThreadMediaDAO - FindPageOfThread): failed executing FindAll -> %w", err)
+	}
+
+	return results, nil
+}
+
+func (threadMediaDAO *ThreadMediaDAO) AppendDeleteUsersByMsgIds(ctx context.Context, msgIds []string, phoneFulls []string) (int64, error) {
+	filter := primitive.M{
+		"msgId": primitive.M{
+			"$in": msgIds,
+		},
+	}
+	update := primitive.M{
+		"$addToSet": primitive.M{
+			"deleteForUsers": primitive.M{"$each": phoneFulls},
+		},
+	}
+
+	count, err := threadMediaDAO.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("(ThreadMediaDAO - AppendDeleteUsersByMsgIds): failed executing UpdateMany -> %w", err)
+	}
+	return count, nil
+}
+
+func (threadMediaDAO *ThreadMediaDAO) AppendDeleteUsersByThreadId(ctx context.Context, threadId string, phoneFulls []string) (int64, error) {
+	filter := primitive.M{
+		"threadId": threadId,
+	}
+	update := primitive.M{
+		"$addToSet": primitive.M{
+			"deleteForUsers": primitive.M{"$each": phoneFulls},
+		},
+	}
+
+	count, err := threadMediaDAO.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("(ThreadMediaDAO - AppendDeleteUsersByThreadId): failed executing UpdateMany -> %w", err)
+	}
+	return count, nil
+}
+
+// DeleteByFileId removes the file from the shared media of its msg, when the file is released
+func (threadMediaDAO *ThreadMediaDAO) DeleteByFileId(ctx context.Context, msgId string, fileId string) (int64, error) {
+	filter := primitive.M{
+		"msgId":  msgId,
+		"fileId": fileId,
+	}
+
+	result, err := threadMediaDAO.Collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("(ThreadMediaDAO - DeleteByFileId): failed executing DeleteMany -> %w", err)
+	}
+	return result.DeletedCount, nil
+}
@@ -0,0 +1,219 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"log"
+	"sol.go/cwm/model"
+	"sync"
+	"time"
+)
+
+type UnreadCounterDAO struct {
+	DAO
+}
+
+var singletonUnreadCounterDAO *UnreadCounterDAO
+var onceUnreadCounterDAO sync.Once
+
+func GetUnreadCounterDAO() *UnreadCounterDAO {
+	onceUnreadCounterDAO.Do(func() {
+		fmt.Println("Init UnreadCounterDAO...")
+
+		db := GetDataBase()
+		mongoCtx, cancelMongo := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancelMongo()
+
+		unreadCounterDAO := UnreadCounterDAO{}
+		unreadCounterDAO.Init(mongoCtx, &db.MongoDb)
+
+		singletonUnreadCounterDAO = &unreadCounterDAO
+	})
+	return singletonUnreadCounterDAO
+}
+
+func (unreadCounterDAO *UnreadCounterDAO) Init(ctx context.Context, db *mongo.Database) {
+	COLLECTION_NAME := "unreadCounters"
+	CACHE_TTL := 10 * time.Minute
+	CACHE_LOCK_TTL := 30 * time.Second
+	unreadCounterDAO.InitDAO(ctx, db, COLLECTION_NAME, []string{}, CACHE_TTL, CACHE_LOCK_TTL)
+
+	//the counters are read by phoneFull
+	_, err := unreadCounterDAO.Collection.Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys: bson.D{{Key: "phoneFull", Value: 1}},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// IncreaseByThreadId increases the counters of the thread for the phoneFulls by a msg created at msgCreatedAt, the missing counters are created.
+// The counters recounted after the msg was created already count it, they are left as is
+func (unreadCounterDAO *UnreadCounterDAO) IncreaseByThreadId(ctx context.Context, threadId string, phoneFulls []string, delta int64, msgCreatedAt int64) error {
+	if len(phoneFulls) == 0 {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	models := []mongo.WriteModel{}
+	for _, phoneFull := range phoneFulls {
+		filter := primitive.M{
+			PKEY_NAME: model.GetUnreadCounterId(phoneFull, threadId),
+			"recountedAt": primitive.M{
+				"$not": primitive.M{"$gte": msgCreatedAt},
+			},
+		}
+		update := primitive.M{
+			"$inc": primitive.M{
+				"count":   delta,
+				"version": 1,
+			},
+			"$set": primitive.M{"lastModified": now},
+			"$setOnInsert": primitive.M{
+				"phoneFull": phoneFull,
+				"threadId":  threadId,
+			},
+		}
+
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(update).
+			SetUpsert(true))
+	}
+
+	_, err := unreadCounterDAO.Collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil && !onlyDuplicateKeyErrors(err) {
+		return fmt.Errorf("(UnreadCounterDAO - IncreaseByThreadId): failed executing BulkWrite -> %w", err)
+	}
+
+	return nil
+}
+
+// onlyDuplicateKeyErrors - the upsert of a counter which is excluded by its recountedAt fails on the unique pkey, that is expected
+func onlyDuplicateKeyErrors(err error) bool {
+	var bulkWriteErr mongo.BulkWriteException
+	if !errors.As(err, &bulkWriteErr) || bulkWriteErr.WriteConcernError != nil {
+		return false
+	}
+
+	for _, writeErr := range bulkWriteErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr.WriteError) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FindByPhoneFullAndThreadId returns the counter of the user in the thread, nil if the thread has no counter of the user yet
+func (unreadCounterDAO *UnreadCounterDAO) FindByPhoneFullAndThreadId(ctx context.Context, phoneFull string, threadId string) (*model.UnreadCounter, error) {
+	result := &model.UnreadCounter{}
+	err := unreadCounterDAO.FindByPKey(ctx, model.GetUnreadCounterId(phoneFull, threadId), result)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("(UnreadCounterDAO - FindByPhoneFullAndThreadId): failed executing FindByPKey -> %w", err)
+	}
+
+	return result, nil
+}
+
+// SetCount replaces the counter of the user in the thread by a recount of the msgs created until recountedAt.
+// The counter is only replaced while it is still at version, false is returned when it was changed meanwhile
+func (unreadCounterDAO *UnreadCounterDAO) SetCount(ctx context.Context, phoneFull string, threadId string, count int64, recountedAt int64, version int64) (bool, error) {
+	filter := primitive.M{
+		PKEY_NAME: model.GetUnreadCounterId(phoneFull, threadId),
+		"version": version,
+	}
+	if version == 0 {
+		//not created yet, or created before the version was stored
+		filter["version"] = primitive.M{
+			"$in": []interface{}{0, nil},
+		}
+	}
+
+	update := primitive.M{
+		"$set": primitive.M{
+			"phoneFull":    phoneFull,
+			"threadId":     threadId,
+			"count":        count,
+			"recountedAt":  recountedAt,
+			"lastModified": time.Now().UnixMilli(),
+		},
+		"$inc": primitive.M{
+			"version": 1,
+		},
+	}
+
+	result, err := unreadCounterDAO.Collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("(UnreadCounterDAO - SetCount): failed executing UpdateOne -> %w", err)
+	}
+
+	return result.MatchedCount > 0 || result.UpsertedCount > 0, nil
+}
+
+func (unreadCounterDAO *UnreadCounterDAO) DeleteByThreadId(ctx context.Context, threadId string, phoneFulls []string) error {
+	counterIds := []string{}
+	for _, phoneFull := range phoneFulls {
+		counterIds = append(counterIds, model.GetUnreadCounterId(phoneFull, threadId))
+	}
+
+	filter := primitive.M{
+		PKEY_NAME: primitive.M{
+			"$in": counterIds,
+		},
+	}
+
+	_, err := unreadCounterDAO.Collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("(UnreadCounterDAO - DeleteByThreadId): failed executing DeleteMany -> %w", err)
+	}
+
+	return nil
+}
+
+// FindAllByPhoneFull returns the counters of the threads which have unread msgs
+func (unreadCounterDAO *UnreadCounterDAO) FindAllByPhoneFull(ctx context.Context, phoneFull string) ([]*model.UnreadCounter, error) {
+	results := []*model.UnreadCounter{}
+	filter := primitive.M{
+		"phoneFull": phoneFull,
+		"count": primitive.M{
+			"$gt": 0,
+		},
+	}
+
+	err := unreadCounterDAO.FindAll(ctx, filter, options.Find(), &results)
+	if err != nil {
+		return nil, fmt.Errorf("(UnreadCounterDAO - FindAllByPhoneFull): failed executing FindAll -> %w", err)
+	}
+
+	return results, nil
+}
+
+// TotalByPhoneFull - the badge number of the user
+func (unreadCounterDAO *UnreadCounterDAO) TotalByPhoneFull(ctx context.Context, phoneFull string) (int64, error) {
+	counters, err := unreadCounterDAO.FindAllByPhoneFull(ctx, phoneFull)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, counter := range counters {
+		total += counter.Count
+	}
+
+	return total, nil
+}
@@ -0,0 +1,102 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"sol.go/cwm/model"
+	"sync"
+	"time"
+)
+
+type UploadSessionDAO struct {
+	DAO
+}
+
+var singletonUploadSessionDAO *UploadSessionDAO
+var onceUploadSessionDAO sync.Once
+
+func GetUploadSessionDAO() *UploadSessionDAO {
+	onceUploadSessionDAO.Do(func() {
+		fmt.Println("Init UploadSessionDAO...")
+
+		db := GetDataBase()
+		mongoCtx, cancelMongo := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancelMongo()
+
+		uploadSessionDAO := UploadSessionDAO{}
+		uploadSessionDAO.Init(mongoCtx, &db.MongoDb)
+
+		singletonUploadSessionDAO = &uploadSessionDAO
+	})
+	return singletonUploadSessionDAO
+}
+
+func (uploadSessionDAO *UploadSessionDAO) Init(ctx context.Context, db *mongo.Database) {
+	COLLECTION_NAME := "uploadSessions"
+	CACHE_TTL := 10 * time.Minute
+	CACHE_LOCK_TTL := 2 * time.Minute //held while a chunk is uploaded to S3
+	uploadSessionDAO.InitDAO(ctx, db, COLLECTION_NAME, []string{}, CACHE_TTL, CACHE_LOCK_TTL)
+}
+
+func (uploadSessionDAO *UploadSessionDAO) Save(ctx context.Context, uploadSession *model.UploadSession) (*model.UploadSession, error) {
+	result := &model.UploadSession{}
+	err := uploadSessionDAO.InsertOrUpdate(ctx, uploadSession, result)
+	if err != nil {
+		return nil, fmt.Errorf("(UploadSessionDAO - Save): failed executing Save -> %w", err)
+	}
+
+	return result, nil
+}
+
+func (uploadSessionDAO *UploadSessionDAO) FindByUploadId(ctx context.Context, uploadId string) (*model.UploadSession, error) {
+	result := &model.UploadSession{}
+
+	err := uploadSessionDAO.FindByPKey(ctx, uploadId, result)
+	if err != nil {
+		return nil, fmt.Errorf("(UploadSessionDAO - FindByUploadId): failed executing FindByPKey -> %w", err)
+	}
+
+	return result, nil
+}
+
+func (uploadSessionDAO *UploadSessionDAO) UpdateByUploadId(ctx context.Context, uploadId string, update interface{}) (*model.UploadSession, error) {
+	result := &model.UploadSession{}
+	err := uploadSessionDAO.UpdateByPKey(ctx, uploadId, update, []interface{}{}, false, result)
+	if err != nil {
+		return nil, fmt.Errorf("(UploadSessionDAO - UpdateByUploadId): failed executing UpdateByPKey -> %w", err)
+	}
+
+	return result, nil
+}
+
+func (uploadSessionDAO *UploadSessionDAO) DeleteByUploadId(ctx context.Context, uploadId string) (*model.UploadSession, error) {
+	result := &model.UploadSession{}
+	err := uploadSessionDAO.DeleteByPKey(ctx, uploadId, result)
+	if err != nil {
+		return nil, fmt.Errorf("(UploadSessionDAO - DeleteByUploadId): failed executing DeleteByPKey -> %w", err)
+	}
+	return result, nil
+}
+
+// FindAllExpired returns the uploads which have not received any chunk until their expiredAt
+func (uploadSessionDAO *UploadSessionDAO) FindAllExpired(ctx context.Context, now int64, limit int64) ([]*model.UploadSession, error) {
+	results := []*model.UploadSession{}
+	filter := primitive.M{
+		"expiredAt": primitive.M{
+			"$lt": now,
+		},
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(limit)
+
+	err := uploadSessionDAO.FindAll(ctx, filter, findOptions, &results)
+	if err != nil {
+		return nil, fmt.Errorf("(UploadSessionDAO - FindAllExpired): failed executing FindAll -> %w", err)
+	}
+
+	return results, nil
+}
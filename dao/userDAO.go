@@ -356,3 +356,85 @@ func (userDAO *UserDAO) UpdateGroupThread(ctx context.Context, phoneFull string,
 
 	return userDAO.UpdateByPhoneFull(ctx, phoneFull, update, []interface{}{}, false)
 }
+
+// RecordSessionPushFailure increases the push failure count of the session, invalidToken (if any) is cleared from the session
+// only when it is still the session's token, so a token updated meanwhile is kept
+func (userDAO *UserDAO) RecordSessionPushFailure(ctx context.Context, phoneFull string, sessionId string, invalidToken string) (*model.User, error) {
+	updateFields := primitive.M{}
+	updateFields["sessions.$[failedSession].lastPushFailureAt"] = time.Now().UnixMilli()
+
+	arrayFilters := []interface{}{
+		primitive.M{"failedSession.sessionId": sessionId},
+	}
+
+	update := primitive.M{
+		"$set": updateFields,
+		"$inc": primitive.M{"sessions.$[failedSession].pushFailureCount": 1},
+	}
+
+	if len(invalidToken) > 0 {
+		update["$unset"] = primitive.M{
+			"sessions.$[primaryTokenSession].pushtokenID":            "",
+			"sessions.$[secondaryTokenSession].secondaryPushtokenID": "",
+		}
+		arrayFilters = append(arrayFilters,
+			primitive.M{"primaryTokenSession.sessionId": sessionId, "primaryTokenSession.pushtokenID": invalidToken},
+			primitive.M{"secondaryTokenSession.sessionId": sessionId, "secondaryTokenSession.secondaryPushtokenID": invalidToken},
+		)
+	}
+
+	user, err := userDAO.UpdateByPhoneFull(ctx, phoneFull, update, arrayFilters, false)
+	if err != nil {
+		return nil, fmt.Errorf("(UserDAO - RecordSessionPushFailure): failed executing UpdateByPhoneFull -> %w", err)
+	}
+	return user, nil
+}
+
+// UpdateThreadNotificationSetting applies change to the user's notification setting of the thread,
+// settings which are back to the default (not muted, not mentions only) are removed
+func (userDAO *UserDAO) UpdateThreadNotificationSetting(ctx context.Context, phoneFull string, threadId string, change func(setting *model.ThreadNotificationSetting)) (*model.User, *model.ThreadNotificationSetting, error) {
+	mutexName := fmt.Sprintf("%v_%v", userDAO.CollectionName, phoneFull)
+
+	redLock := userDAO.CreateRedlock(ctx, mutexName, userDAO.CacheLockTTL)
+
+	log.Println("try acquire Lock", mutexName)
+	err := redLock.Lock()
+	defer func() {
+		ok, err := redLock.Unlock()
+		log.Println("release Lock", mutexName, ok, err)
+	}()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("(UserDAO - UpdateThreadNotificationSetting): failed executing redLock.Lock -> %w", err)
+	}
+
+	user, err := userDAO.FindByPhoneFull(ctx, phoneFull)
+	if err != nil {
+		return nil, nil, fmt.Errorf("(UserDAO - UpdateThreadNotificationSetting): failed executing FindByPhoneFull -> %w", err)
+	}
+
+	setting := model.ThreadNotificationSetting{ThreadId: threadId}
+	settings := []model.ThreadNotificationSetting{}
+	for _, s := range user.ThreadNotificationSettings {
+		if s.ThreadId == threadId {
+			setting = s
+		} else if !s.IsDefault(time.Now()) { //drop the expired mutes too
+			settings = append(settings, s)
+		}
+	}
+
+	change(&setting)
+	if !setting.IsDefault(time.Now()) {
+		settings = append(settings, setting)
+	}
+
+	updateFields := primitive.M{}
+	updateFields["threadNotificationSettings"] = settings
+	update := primitive.M{"$set": updateFields}
+
+	user, err = userDAO.UpdateByPhoneFull(ctx, phoneFull, update, []interface{}{}, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, &setting, nil
+}
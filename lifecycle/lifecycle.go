@@ -0,0 +1,82 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+type ShutdownFunc func(ctx context.Context) error
+
+type shutdownHook struct {
+	name string
+	fn   ShutdownFunc
+}
+
+type Lifecycle struct {
+	lock  sync.Mutex
+	hooks []shutdownHook
+}
+
+var (
+	singletonLifecycle *Lifecycle
+	onceLifecycle      sync.Once
+)
+
+func GetLifecycle() *Lifecycle {
+	onceLifecycle.Do(func() {
+		fmt.Println("Init Lifecycle...")
+		singletonLifecycle = &Lifecycle{}
+	})
+	return singletonLifecycle
+}
+
+// OnShutdown registers a shutdown hook, hooks are run one by one in registration order
+func (l *Lifecycle) OnShutdown(name string, fn ShutdownFunc) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.hooks = append(l.hooks, shutdownHook{
+		name: name,
+		fn:   fn,
+	})
+}
+
+// WaitForShutdown blocks until SIGINT or SIGTERM is received, then runs the shutdown hooks within the timeout
+func (l *Lifecycle) WaitForShutdown(timeout time.Duration) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+
+	//Block until a signal is received
+	sig := <-ch
+	log.Printf("Lifecycle - received %v, shutting down in %v\n", sig, timeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	l.Shutdown(ctx)
+}
+
+// Shutdown runs all hooks, hooks which are reached after the deadline still run with the expired ctx so they can release resources
+func (l *Lifecycle) Shutdown(ctx context.Context) {
+	l.lock.Lock()
+	hooks := make([]shutdownHook, len(l.hooks))
+	copy(hooks, l.hooks)
+	l.lock.Unlock()
+
+	for _, hook := range hooks {
+		fmt.Println("Lifecycle - stopping", hook.name)
+		startTime := time.Now()
+		err := hook.fn(ctx)
+		if err != nil {
+			log.Printf("Lifecycle - stop %v err: %v\n", hook.name, err)
+		} else {
+			log.Printf("Lifecycle - stopped %v in %v\n", hook.name, time.Since(startTime))
+		}
+	}
+}
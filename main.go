@@ -2,21 +2,29 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
-	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
-	"google.golang.org/grpc"
 	"log"
 	"net"
 	"os"
-	"os/signal"
+	"sol.go/cwm/appapns"
 	"sol.go/cwm/appfirebase"
 	"sol.go/cwm/appgrpc"
 	"sol.go/cwm/apphttp"
+	"sol.go/cwm/apppush"
+	"sol.go/cwm/appupload"
+	"sol.go/cwm/appwebpush"
 	"sol.go/cwm/appws"
+	"sol.go/cwm/blobstore"
 	"sol.go/cwm/dao"
+	"sol.go/cwm/lifecycle"
+	"sol.go/cwm/proto/grpcCWMPb"
 	"sol.go/cwm/pubsub"
+	"sol.go/cwm/scanner"
+	"sol.go/cwm/sip"
+	"sol.go/cwm/static"
 	"strconv"
 	"time"
 )
@@ -70,48 +78,147 @@ func main() {
 		log.Fatalf("Failed to connect to redis: %v", err)
 	}
 
-	listener, _, grpcServer, err := initServices(httpPort, grpcPort)
+	err = initServices(httpPort, grpcPort)
 	if err != nil {
 		log.Fatalf("Failed to init services: %v", err)
 	}
 
-	//Setup shutdown hook
-	// Wait for Ctrl+C to exit
-	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, os.Interrupt)
-
-	//Block until a signal is received
-	<-ch
-
-	fmt.Println("Stopping grpc server")
-	grpcServer.Stop()
-	fmt.Println("Stopping grpc listener")
-	_ = listener.Close()
-
-	fmt.Println("Closing mongodb connection")
-	_ = db.MongoClient.Disconnect(mongoCtx)
+	//Block until SIGINT/SIGTERM is received, then stop all services
+	lifecycle.GetLifecycle().WaitForShutdown(static.ShutdownTimeout * time.Second)
 	fmt.Println("End of programm")
 }
 
-func initServices(httpPort int, grpcPort int) (net.Listener, *gin.Engine, *grpc.Server, error) {
+func initServices(httpPort int, grpcPort int) error {
+	appLifecycle := lifecycle.GetLifecycle()
 
 	listener, grpcServer, err := appgrpc.StartServer(grpcPort)
 	if err != nil {
-		return nil, nil, nil, err
+		return err
 	}
 
-	appFireBase := appfirebase.GetAppFireBase()
-	appFireBase.Start()
+	appPush := apppush.GetAppPush()
+	initPushProviders(appPush)
+	appPush.Start()
 
 	ws := appws.GetWS()
 	ws.Start()
+	appgrpc.RegisterWSRPC(ws)
 
-	router, err := apphttp.StartServer(httpPort, ws.Server)
+	httpListener, httpServer, err := apphttp.StartServer(httpPort, ws.Server)
 	if err != nil {
-		return nil, nil, nil, err
+		return err
 	}
 
 	pubsub.StartSubscribe()
 
-	return listener, router, grpcServer, nil
+	blobstore.GetBlobStore()
+	scanner.GetScanner()
+	appupload.GetMediaURLSigner() //refuses to start without MEDIA_URL_KEY
+
+	uploadManager := appupload.GetUploadManager()
+	uploadManager.Start()
+
+	mediaGC := appupload.GetMediaGC()
+	mediaGC.Start()
+
+	mediaScanSweep := appupload.GetMediaScanSweep()
+	mediaScanSweep.Start()
+
+	go func() {
+		sip.BackfillImType(context.Background())
+		sip.BackfillThreadMedia(context.Background())
+	}()
+
+	//shutdown order: stop taking requests -> tell the ws clients to reconnect elsewhere -> drain msg queues -> close connections to mongodb/redis
+	appLifecycle.OnShutdown("grpc server", func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop() //also closes the listener
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			grpcServer.Stop()
+			_ = listener.Close()
+			return ctx.Err()
+		}
+	})
+	appLifecycle.OnShutdown("http listener", func(ctx context.Context) error {
+		//no new engine.io/ws handshakes, the open polling requests still carry the shutdown event to the sockets
+		httpServer.SetKeepAlivesEnabled(false)
+		return httpListener.Close()
+	})
+	appLifecycle.OnShutdown("ws sockets", ws.CloseSockets)
+	appLifecycle.OnShutdown("http server", func(ctx context.Context) error {
+		err := httpServer.Shutdown(ctx)
+		_ = ws.Server.Close()
+		//the listener is already closed
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+		return err
+	})
+	appLifecycle.OnShutdown("ws send queue", ws.Stop)
+	appLifecycle.OnShutdown("push send queue", appPush.Stop)
+	appLifecycle.OnShutdown("upload expiry job", uploadManager.Stop)
+	appLifecycle.OnShutdown("media gc job", mediaGC.Stop)
+	appLifecycle.OnShutdown("media scan sweep job", mediaScanSweep.Stop)
+	appLifecycle.OnShutdown("redis subscriber", pubsub.StopSubscribe)
+	appLifecycle.OnShutdown("mongodb connection", func(ctx context.Context) error {
+		return dao.GetDataBase().MongoClient.Disconnect(ctx)
+	})
+	appLifecycle.OnShutdown("redis connection", func(ctx context.Context) error {
+		return dao.GetCache().RedisClient.Close()
+	})
+
+	return nil
+}
+
+// initPushProviders selects the push provider of each OS type from PUSH_PROVIDER_ANDROID (fcm|fake|none), PUSH_PROVIDER_IOS (apns|fake|none)
+// and PUSH_PROVIDER_WEBAPP (webpush|fake|none),
+// a provider which can not be initialized (e.g. missing credentials) is disabled
+func initPushProviders(appPush *apppush.AppPush) {
+	providerNames := map[grpcCWMPb.OS_TYPE]string{
+		grpcCWMPb.OS_TYPE_ANDROID: os.Getenv("PUSH_PROVIDER_ANDROID"),
+		grpcCWMPb.OS_TYPE_IOS:     os.Getenv("PUSH_PROVIDER_IOS"),
+		grpcCWMPb.OS_TYPE_WEBAPP:  os.Getenv("PUSH_PROVIDER_WEBAPP"),
+	}
+	defaultProviderNames := map[grpcCWMPb.OS_TYPE]string{
+		grpcCWMPb.OS_TYPE_ANDROID: apppush.PUSH_PROVIDER_FCM,
+		grpcCWMPb.OS_TYPE_IOS:     apppush.PUSH_PROVIDER_APNS,
+		grpcCWMPb.OS_TYPE_WEBAPP:  apppush.PUSH_PROVIDER_WEBPUSH,
+	}
+
+	for osType, providerName := range providerNames {
+		if len(providerName) == 0 {
+			providerName = defaultProviderNames[osType]
+		}
+
+		var provider apppush.PushProvider
+		var err error
+		switch providerName {
+		case apppush.PUSH_PROVIDER_FCM:
+			provider, err = appfirebase.NewAppFireBase()
+		case apppush.PUSH_PROVIDER_APNS:
+			provider, err = appapns.NewAppAPNs()
+		case apppush.PUSH_PROVIDER_WEBPUSH:
+			provider, err = appwebpush.NewAppWebPush()
+		case apppush.PUSH_PROVIDER_FAKE:
+			provider = apppush.NewFakePushProvider(providerName)
+		case apppush.PUSH_PROVIDER_NONE:
+			provider = &apppush.NoopPushProvider{ProviderName: providerName}
+		default:
+			err = fmt.Errorf("unknown push provider: %v", providerName)
+		}
+
+		if err != nil {
+			log.Printf("Failed to init push provider %v of %v, push is disabled: %v\n", providerName, osType, err)
+			provider = &apppush.NoopPushProvider{ProviderName: apppush.PUSH_PROVIDER_NONE}
+		}
+
+		appPush.SetProvider(osType, provider)
+	}
 }
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BlobRef - number of S3FileInfo referring to a blob, the blobs are named from their content so the same content is stored once
+type BlobRef struct {
+	ID               primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+	BlobKey          string             `json:"blobKey" bson:"pkey,omitempty" validate:"required"` //S3FileInfo.FileName
+	Checksum         string             `json:"checksum" bson:"checksum,omitempty" validate:"required"`
+	FileSize         int64              `json:"fileSize" bson:"fileSize,omitempty" validate:"required"`
+	MimeType         string             `json:"mimeType" bson:"mimeType,omitempty"`
+	OriginalChecksum string             `json:"originalChecksum" bson:"originalChecksum,omitempty"` //content received from the client before the sanitization
+	OriginalFileSize int64              `json:"originalFileSize" bson:"originalFileSize,omitempty"`
+	RefCount         int64              `json:"refCount" bson:"refCount"`
+	Variants         []FileVariant      `json:"variants" bson:"variants,omitempty"`     //shared by the S3FileInfo of the blob
+	ScanStatus       string             `json:"scanStatus" bson:"scanStatus,omitempty"` //result of the scan, shared by the S3FileInfo of the blob
+	BlockedReason    string             `json:"blockedReason" bson:"blockedReason,omitempty"`
+	QuarantineKey    string             `json:"quarantineKey" bson:"quarantineKey,omitempty"` //the blocked blob is moved out of BlobKey
+	CreatedAt        int64              `json:"createdAt" bson:"createdAt,omitempty"`
+	LastModified     int64              `json:"lastModified" bson:"lastModified,omitempty"`
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"golang.org/x/exp/slices"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"time"
+)
+
+const (
+	MUTED_UNTIL_UNMUTE = -1
+)
+
+type ThreadNotificationSetting struct {
+	ThreadId     string `json:"threadId" bson:"threadId,omitempty"`
+	MutedUntil   int64  `json:"mutedUntil" bson:"mutedUntil,omitempty"` //0 - not muted, MUTED_UNTIL_UNMUTE, else unix milli
+	MentionsOnly bool   `json:"mentionsOnly" bson:"mentionsOnly,omitempty"`
+}
+
+type DoNotDisturbSchedule struct {
+	Enabled     bool   `json:"enabled" bson:"enabled,omitempty"`
+	StartMinute int32  `json:"startMinute" bson:"startMinute,omitempty"` //minutes from 00:00
+	EndMinute   int32  `json:"endMinute" bson:"endMinute,omitempty"`
+	Timezone    string `json:"timezone" bson:"timezone,omitempty"` //IANA time zone
+}
+
+func (setting *ThreadNotificationSetting) IsMuted(now time.Time) bool {
+	return setting.MutedUntil == MUTED_UNTIL_UNMUTE || setting.MutedUntil > now.UnixMilli()
+}
+
+// IsDefault - a setting which changes nothing is not stored
+func (setting *ThreadNotificationSetting) IsDefault(now time.Time) bool {
+	return !setting.IsMuted(now) && !setting.MentionsOnly
+}
+
+// IsActive - the schedule may cross midnight (e.g. 22:00 - 07:00), an invalid timezone is treated as UTC
+func (schedule *DoNotDisturbSchedule) IsActive(now time.Time) bool {
+	if !schedule.Enabled || schedule.StartMinute == schedule.EndMinute {
+		return false
+	}
+
+	location, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	localNow := now.In(location)
+	minute := int32(localNow.Hour()*60 + localNow.Minute())
+
+	if schedule.StartMinute < schedule.EndMinute {
+		return minute >= schedule.StartMinute && minute < schedule.EndMinute
+	}
+	return minute >= schedule.StartMinute || minute < schedule.EndMinute
+}
+
+// GetThreadNotificationSetting returns the user's setting of the thread, nil if the thread uses the default
+func (user *User) GetThreadNotificationSetting(threadId string) *ThreadNotificationSetting {
+	idx := slices.IndexFunc(user.ThreadNotificationSettings, func(s ThreadNotificationSetting) bool { return s.ThreadId == threadId })
+	if idx < 0 {
+		return nil
+	}
+	return &user.ThreadNotificationSettings[idx]
+}
+
+func (setting *ThreadNotificationSetting) ToProto() *cwmSignalMsgPb.ThreadNotificationSetting {
+	return &cwmSignalMsgPb.ThreadNotificationSetting{
+		ThreadId:     setting.ThreadId,
+		MutedUntil:   setting.MutedUntil,
+		MentionsOnly: setting.MentionsOnly,
+	}
+}
+
+// ToProto - nil schedule => disabled
+func (schedule *DoNotDisturbSchedule) ToProto() *cwmSignalMsgPb.DoNotDisturbSchedule {
+	if schedule == nil {
+		return &cwmSignalMsgPb.DoNotDisturbSchedule{}
+	}
+
+	return &cwmSignalMsgPb.DoNotDisturbSchedule{
+		Enabled:     schedule.Enabled,
+		StartMinute: schedule.StartMinute,
+		EndMinute:   schedule.EndMinute,
+		Timezone:    schedule.Timezone,
+	}
+}
+
+// NotificationSettingsToProto returns the user's thread settings which are still in effect
+func (user *User) NotificationSettingsToProto() *cwmSignalMsgPb.SignalEventMessageNotificationSettingsChanged {
+	now := time.Now()
+	threadSettings := []*cwmSignalMsgPb.ThreadNotificationSetting{}
+	for _, setting := range user.ThreadNotificationSettings {
+		if !setting.IsDefault(now) {
+			threadSettings = append(threadSettings, setting.ToProto())
+		}
+	}
+
+	return &cwmSignalMsgPb.SignalEventMessageNotificationSettingsChanged{
+		ThreadSettings: threadSettings,
+		DoNotDisturb:   user.DoNotDisturb.ToProto(),
+	}
+}
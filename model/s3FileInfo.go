@@ -3,15 +3,47 @@ package model
 import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/proto/grpcCWMPb"
+)
+
+const (
+	SCAN_STATUS_PENDING = "pending"
+	SCAN_STATUS_CLEAN   = "clean"
+	SCAN_STATUS_BLOCKED = "blocked"
 )
 
 type S3FileInfo struct {
-	ID        primitive.ObjectID               `json:"_id" bson:"_id,omitempty"`
-	FileId    string                           `json:"fileId" bson:"pkey,omitempty" validate:"required"`
-	MsgId     string                           `json:"msgId" bson:"msgId,omitempty" validate:"required"`
-	FileName  string                           `json:"fileName" bson:"fileName,omitempty" validate:"required"`
-	FileSize  int64                            `json:"fileSize" bson:"fileSize,omitempty" validate:"required"`
-	Checksum  string                           `json:"checksum" bson:"checksum,omitempty" validate:"required"`
-	MediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE `json:"mediaType" bson:"mediaType,omitempty" validate:"gte=0"`
-	CreatedAt int64                            `json:"createdAt" bson:"createdAt,omitempty" validate:"required"`
+	ID               primitive.ObjectID               `json:"_id" bson:"_id,omitempty"`
+	FileId           string                           `json:"fileId" bson:"pkey,omitempty" validate:"required"`
+	MsgId            string                           `json:"msgId" bson:"msgId,omitempty" validate:"required"`
+	ThreadId         string                           `json:"threadId" bson:"threadId,omitempty"` //thread of the msg, empty for the avatars
+	Uploader         string                           `json:"uploader" bson:"uploader,omitempty"` //phoneFull of the sender of the msg
+	FileName         string                           `json:"fileName" bson:"fileName,omitempty" validate:"required"`
+	FileSize         int64                            `json:"fileSize" bson:"fileSize,omitempty" validate:"required"`
+	Checksum         string                           `json:"checksum" bson:"checksum,omitempty" validate:"required"`
+	MediaType        cwmSignalMsgPb.SIGNAL_MEDIA_TYPE `json:"mediaType" bson:"mediaType,omitempty" validate:"gte=0"`
+	OriginalChecksum string                           `json:"originalChecksum" bson:"originalChecksum,omitempty"` //md5 declared by the client, differs from Checksum once the image is sanitized
+	OriginalFileSize int64                            `json:"originalFileSize" bson:"originalFileSize,omitempty"`
+	Variants         []FileVariant                    `json:"variants" bson:"variants,omitempty"`     //thumbnails of the images
+	ScanStatus       string                           `json:"scanStatus" bson:"scanStatus,omitempty"` //empty if not scanned: not a doc/file or uploaded before the scans
+	BlockedReason    string                           `json:"blockedReason" bson:"blockedReason,omitempty"`
+	CreatedAt        int64                            `json:"createdAt" bson:"createdAt,omitempty" validate:"required"`
+}
+
+// FileVariant is a smaller copy of an image, stored next to the original blob
+type FileVariant struct {
+	Variant  grpcCWMPb.MEDIA_VARIANT `json:"variant" bson:"variant"`
+	FileName string                  `json:"fileName" bson:"fileName"`
+	FileSize int64                   `json:"fileSize" bson:"fileSize"`
+	Width    int32                   `json:"width" bson:"width"`
+	Height   int32                   `json:"height" bson:"height"`
+}
+
+func (s3FileInfo *S3FileInfo) FindVariant(variant grpcCWMPb.MEDIA_VARIANT) *FileVariant {
+	for i := range s3FileInfo.Variants {
+		if s3FileInfo.Variants[i].Variant == variant {
+			return &s3FileInfo.Variants[i]
+		}
+	}
+	return nil
 }
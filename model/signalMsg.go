@@ -14,7 +14,9 @@ type SignalMsg struct {
 	To                    string                            `json:"to" bson:"to,omitempty" validate:"required"`                   //not available in group chat
 	ToSessionsWhiteList   []string                          `json:"toSessionsWhiteList" bson:"toSessionsWhiteList,omitempty"`     //not available in group chat
 	ReceivedSessions      []string                          `json:"receivedSessions" bson:"receivedSessions,omitempty" validate:"required"`
+	PushedSessions        []string                          `json:"pushedSessions" bson:"pushedSessions,omitempty"` //sessions which did not ack the socket delivery in time
 	ThreadType            cwmSignalMsgPb.SIGNAL_THREAD_TYPE `json:"threadType" bson:"threadType,omitempty" validate:"gte=0"`
+	ImType                cwmSignalMsgPb.SIGNAL_IM_TYPE     `json:"imType" bson:"imType,omitempty" validate:"gte=0"`
 	DeleteForUsers        []string                          `json:"deleteForUsers" bson:"deleteForUsers,omitempty"`
 	SeenByUsers           []string                          `json:"seenByUsers" bson:"seenByUsers,omitempty"`
 	CwmData               []byte                            `json:"cwmData" bson:"cwmData,omitempty" validate:"required"` //base64
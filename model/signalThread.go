@@ -6,14 +6,30 @@ import (
 )
 
 type SignalThread struct {
-	ID              primitive.ObjectID                `json:"_id" bson:"_id,omitempty"`
-	ThreadId        string                            `json:"threadId" bson:"pkey,omitempty" validate:"required"`
-	GroupName       string                            `json:"groupName" bson:"groupName,omitempty"`
-	Type            cwmSignalMsgPb.SIGNAL_THREAD_TYPE `json:"type" bson:"type,omitempty" validate:"gte=0"`
-	AllParticipants []string                          `json:"allParticipants" bson:"allParticipants,omitempty" validate:"required"` //allParticipants included removed members
-	Participants    []string                          `json:"participants" bson:"participants,omitempty" validate:"required"`       //current participants of group
-	Creator         string                            `json:"creator" bson:"creator,omitempty"`
-	Admins          []string                          `json:"admins" bson:"admins,omitempty"`
-	CreatedAt       int64                             `json:"createdAt" bson:"createdAt,omitempty" validate:"required"`
-	LastModified    int64                             `json:"lastModified" bson:"lastModified,omitempty" validate:"required"`
+	ID                  primitive.ObjectID                `json:"_id" bson:"_id,omitempty"`
+	ThreadId            string                            `json:"threadId" bson:"pkey,omitempty" validate:"required"`
+	GroupName           string                            `json:"groupName" bson:"groupName,omitempty"`
+	Type                cwmSignalMsgPb.SIGNAL_THREAD_TYPE `json:"type" bson:"type,omitempty" validate:"gte=0"`
+	AllParticipants     []string                          `json:"allParticipants" bson:"allParticipants,omitempty" validate:"required"` //allParticipants included removed members
+	Participants        []string                          `json:"participants" bson:"participants,omitempty" validate:"required"`       //current participants of group
+	RemovedParticipants []RemovedParticipant              `json:"removedParticipants" bson:"removedParticipants,omitempty"`             //last removal of the members who left or were removed
+	Creator             string                            `json:"creator" bson:"creator,omitempty"`
+	Admins              []string                          `json:"admins" bson:"admins,omitempty"`
+	CreatedAt           int64                             `json:"createdAt" bson:"createdAt,omitempty" validate:"required"`
+	LastModified        int64                             `json:"lastModified" bson:"lastModified,omitempty" validate:"required"`
+}
+
+type RemovedParticipant struct {
+	PhoneFull string `json:"phoneFull" bson:"phoneFull"`
+	RemovedAt int64  `json:"removedAt" bson:"removedAt"`
+}
+
+// RemovedAt returns when the member was removed from the thread, 0 if never removed
+func (signalThread *SignalThread) RemovedAt(phoneFull string) int64 {
+	for _, removed := range signalThread.RemovedParticipants {
+		if removed.PhoneFull == phoneFull {
+			return removed.RemovedAt
+		}
+	}
+	return 0
 }
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// StorageUsage - size and number of the files uploaded by a user, the files shared with other msgs are counted for each msg
+type StorageUsage struct {
+	ID           primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+	PhoneFull    string             `json:"phoneFull" bson:"pkey,omitempty" validate:"required"`
+	StoredBytes  int64              `json:"storedBytes" bson:"storedBytes"`
+	FileCount    int64              `json:"fileCount" bson:"fileCount"`
+	CreatedAt    int64              `json:"createdAt" bson:"createdAt,omitempty"`
+	LastModified int64              `json:"lastModified" bson:"lastModified,omitempty"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/proto/grpcCWMPb"
+)
+
+// ThreadMedia indexes a file or an url of a msg for the shared media of its thread, written when the msg is received
+type ThreadMedia struct {
+	ID             primitive.ObjectID               `json:"_id" bson:"_id,omitempty"`
+	MediaKey       string                           `json:"mediaKey" bson:"pkey,omitempty" validate:"required"` //msgId:index of the item in the msg
+	ThreadId       string                           `json:"threadId" bson:"threadId,omitempty" validate:"required"`
+	MsgId          string                           `json:"msgId" bson:"msgId,omitempty" validate:"required"`
+	Sender         string                           `json:"sender" bson:"sender,omitempty"`
+	MediaType      grpcCWMPb.THREAD_MEDIA_TYPE      `json:"mediaType" bson:"mediaType"`
+	FileId         string                           `json:"fileId" bson:"fileId,omitempty"`
+	FileName       string                           `json:"fileName" bson:"fileName,omitempty"`
+	FileSize       int64                            `json:"fileSize" bson:"fileSize,omitempty"`
+	FileType       cwmSignalMsgPb.SIGNAL_MEDIA_TYPE `json:"fileType" bson:"fileType"`
+	MimeType       string                           `json:"mimeType" bson:"mimeType,omitempty"`
+	Checksum       string                           `json:"checksum" bson:"checksum,omitempty"`
+	URL            string                           `json:"url" bson:"url,omitempty"`
+	URLTitle       string                           `json:"urlTitle" bson:"urlTitle,omitempty"`
+	URLThumbnail   string                           `json:"urlThumbnail" bson:"urlThumbnail,omitempty"`
+	DeleteForUsers []string                         `json:"deleteForUsers" bson:"deleteForUsers,omitempty"`           //copied from the SignalMsg
+	CreatedAt      int64                            `json:"createdAt" bson:"createdAt,omitempty" validate:"required"` //server date of the msg
+}
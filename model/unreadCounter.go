@@ -0,0 +1,21 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UnreadCounter - number of unread msgs of a user in a thread
+type UnreadCounter struct {
+	ID           primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+	CounterId    string             `json:"counterId" bson:"pkey,omitempty" validate:"required"` //phoneFull_threadId
+	PhoneFull    string             `json:"phoneFull" bson:"phoneFull,omitempty" validate:"required"`
+	ThreadId     string             `json:"threadId" bson:"threadId,omitempty" validate:"required"`
+	Count        int64              `json:"count" bson:"count"`
+	Version      int64              `json:"version" bson:"version"`                   //bumped by every change of count, guards the recount
+	RecountedAt  int64              `json:"recountedAt" bson:"recountedAt,omitempty"` //the msgs created until then are in count
+	LastModified int64              `json:"lastModified" bson:"lastModified,omitempty"`
+}
+
+func GetUnreadCounterId(phoneFull string, threadId string) string {
+	return phoneFull + "_" + threadId
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+)
+
+// UploadSession is a resumable upload, backed by a multipart upload of the BlobStore - each chunk is a part
+type UploadSession struct {
+	ID         primitive.ObjectID               `json:"_id" bson:"_id,omitempty"`
+	UploadId   string                           `json:"uploadId" bson:"pkey,omitempty" validate:"required"`
+	PhoneFull  string                           `json:"phoneFull" bson:"phoneFull,omitempty" validate:"required"`
+	MsgId      string                           `json:"msgId" bson:"msgId,omitempty" validate:"required"`
+	ThreadId   string                           `json:"threadId" bson:"threadId,omitempty" validate:"required"`
+	MediaType  cwmSignalMsgPb.SIGNAL_MEDIA_TYPE `json:"mediaType" bson:"mediaType,omitempty" validate:"gte=0"`
+	Checksum   string                           `json:"checksum" bson:"checksum,omitempty" validate:"required"` //md5 declared by the client
+	FileSize   int64                            `json:"fileSize" bson:"fileSize,omitempty" validate:"required"`
+	ChunkSize  int64                            `json:"chunkSize" bson:"chunkSize,omitempty" validate:"required"`
+	Offset     int64                            `json:"offset" bson:"offset"`                   //size of the uploaded parts
+	FileName   string                           `json:"fileName" bson:"fileName,omitempty"`     //blob key, set by the first chunk
+	MimeType   string                           `json:"mimeType" bson:"mimeType,omitempty"`     //detected from the first chunk
+	S3UploadId string                           `json:"s3UploadId" bson:"s3UploadId,omitempty"` //multipart upload id of the BlobStore
+	Sanitize   bool                             `json:"sanitize" bson:"sanitize,omitempty"`     //image assembled in a temporary blob, stored once sanitized
+	Parts      []UploadPart                     `json:"parts" bson:"parts,omitempty"`
+	HashState  []byte                           `json:"hashState" bson:"hashState,omitempty"` //md5 state of the uploaded parts
+	CreatedAt  int64                            `json:"createdAt" bson:"createdAt,omitempty" validate:"required"`
+	ExpiredAt  int64                            `json:"expiredAt" bson:"expiredAt,omitempty" validate:"required"`
+
+	//set once the parts are assembled, FileName is then the stored blob
+	CompletedAt    int64  `json:"completedAt" bson:"completedAt,omitempty"`
+	StoredChecksum string `json:"storedChecksum" bson:"storedChecksum,omitempty"`
+	StoredFileSize int64  `json:"storedFileSize" bson:"storedFileSize,omitempty"`
+}
+
+type UploadPart struct {
+	PartNumber int64  `json:"partNumber" bson:"partNumber"`
+	ETag       string `json:"etag" bson:"etag"`
+	Size       int64  `json:"size" bson:"size"`
+}
@@ -30,6 +30,10 @@ type User struct {
 	Gender    int32  `json:"gender" bson:"gender,omitempty"`
 	Birthday  int64  `json:"birthday" bson:"birthday,omitempty"`
 
+	//notification settings, synced across sessions
+	ThreadNotificationSettings []ThreadNotificationSetting `json:"threadNotificationSettings" bson:"threadNotificationSettings,omitempty"`
+	DoNotDisturb               *DoNotDisturbSchedule       `json:"doNotDisturb" bson:"doNotDisturb,omitempty"`
+
 	CreatedAt int64 `json:"createdAt" bson:"createdAt,omitempty" validate:"required"`
 	//UsdtBalance string             `json:"usdtBalance" bson:"usdtBalance,omitempty"`
 }
@@ -10,11 +10,16 @@ type UserSession struct {
 	OsType       grpcCWMPb.OS_TYPE `json:"osType" bson:"osType,omitempty" validate:"gte=0"`
 	OsVersion    string            `json:"osVersion" bson:"osVersion,omitempty" validate:"required"`
 	Online       bool              `json:"online" bson:"online,omitempty"`
+	Locale       string            `json:"locale" bson:"locale,omitempty"` //language of the push notifications
 
-	//FCM - APNS_REMOTE
+	//FCM - APNS_VOIP - WebPush endpoint
 	PushtokenID string `json:"pushtokenID" bson:"pushtokenID,omitempty"`
 
-	//APNS_VOIP
+	//WebPush subscription keys
+	WebPushP256dh string `json:"webPushP256dh" bson:"webPushP256dh,omitempty"`
+	WebPushAuth   string `json:"webPushAuth" bson:"webPushAuth,omitempty"`
+
+	//APNS_REMOTE
 	SecondaryPushtokenID string `json:"secondaryPushtokenID" bson:"secondaryPushtokenID,omitempty"`
 
 	//iOS BundleId
@@ -22,4 +27,24 @@ type UserSession struct {
 
 	//Android AppId
 	AppId string `json:"appId" bson:"appId,omitempty"`
+
+	//consecutive push failures since the last UpdatePushToken
+	PushFailureCount  int32 `json:"pushFailureCount" bson:"pushFailureCount,omitempty"`
+	LastPushFailureAt int64 `json:"lastPushFailureAt" bson:"lastPushFailureAt,omitempty"`
+}
+
+// RemotePushToken - the token of the alert / background pushes: APNS_REMOTE of an iOS session, FCM token or WebPush endpoint of the others
+func (session *UserSession) RemotePushToken() string {
+	if session.OsType == grpcCWMPb.OS_TYPE_IOS {
+		return session.SecondaryPushtokenID
+	}
+	return session.PushtokenID
+}
+
+// VoIPPushToken - the APNS_VOIP token of an iOS session
+func (session *UserSession) VoIPPushToken() string {
+	if session.OsType == grpcCWMPb.OS_TYPE_IOS {
+		return session.PushtokenID
+	}
+	return ""
 }
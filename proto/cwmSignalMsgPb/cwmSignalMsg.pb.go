@@ -410,10 +410,11 @@ func (SIGNAL_GROUP_THREAD_NOTIFICATION_MSG_TYPE) EnumDescriptor() ([]byte, []int
 type SIGNAL_EVENT_MSG_TYPE int32
 
 const (
-	SIGNAL_EVENT_MSG_TYPE_UPDATE_CONTACT_OTT SIGNAL_EVENT_MSG_TYPE = 0
-	SIGNAL_EVENT_MSG_TYPE_MSG_DELETE         SIGNAL_EVENT_MSG_TYPE = 1
-	SIGNAL_EVENT_MSG_TYPE_THREAD_CLEAR_MSG   SIGNAL_EVENT_MSG_TYPE = 2
-	SIGNAL_EVENT_MSG_TYPE_THREAD_DELETED     SIGNAL_EVENT_MSG_TYPE = 3
+	SIGNAL_EVENT_MSG_TYPE_UPDATE_CONTACT_OTT            SIGNAL_EVENT_MSG_TYPE = 0
+	SIGNAL_EVENT_MSG_TYPE_MSG_DELETE                    SIGNAL_EVENT_MSG_TYPE = 1
+	SIGNAL_EVENT_MSG_TYPE_THREAD_CLEAR_MSG              SIGNAL_EVENT_MSG_TYPE = 2
+	SIGNAL_EVENT_MSG_TYPE_THREAD_DELETED                SIGNAL_EVENT_MSG_TYPE = 3
+	SIGNAL_EVENT_MSG_TYPE_NOTIFICATION_SETTINGS_CHANGED SIGNAL_EVENT_MSG_TYPE = 4
 )
 
 // Enum value maps for SIGNAL_EVENT_MSG_TYPE.
@@ -423,12 +424,14 @@ var (
 		1: "MSG_DELETE",
 		2: "THREAD_CLEAR_MSG",
 		3: "THREAD_DELETED",
+		4: "NOTIFICATION_SETTINGS_CHANGED",
 	}
 	SIGNAL_EVENT_MSG_TYPE_value = map[string]int32{
-		"UPDATE_CONTACT_OTT": 0,
-		"MSG_DELETE":         1,
-		"THREAD_CLEAR_MSG":   2,
-		"THREAD_DELETED":     3,
+		"UPDATE_CONTACT_OTT":            0,
+		"MSG_DELETE":                    1,
+		"THREAD_CLEAR_MSG":              2,
+		"THREAD_DELETED":                3,
+		"NOTIFICATION_SETTINGS_CHANGED": 4,
 	}
 )
 
@@ -474,6 +477,7 @@ type SignalMessage struct {
 	Checksum   string             `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
 	Seenby     []string           `protobuf:"bytes,9,rep,name=seenby,proto3" json:"seenby,omitempty"`
 	Data       []byte             `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	Mentions   []string           `protobuf:"bytes,11,rep,name=mentions,proto3" json:"mentions,omitempty"` //phoneFulls mentioned in the msg, notified in "mentions only" threads
 }
 
 func (x *SignalMessage) Reset() {
@@ -578,6 +582,13 @@ func (x *SignalMessage) GetData() []byte {
 	return nil
 }
 
+func (x *SignalMessage) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
 type SignalTypingMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1442,12 +1453,202 @@ func (x *SignalEventMessageThreadDeleted) GetDeleteForAllMembers() bool {
 	return false
 }
 
+type SignalEventMessageNotificationSettingsChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadSettings []*ThreadNotificationSetting `protobuf:"bytes,1,rep,name=threadSettings,proto3" json:"threadSettings,omitempty"`
+	DoNotDisturb   *DoNotDisturbSchedule        `protobuf:"bytes,2,opt,name=doNotDisturb,proto3" json:"doNotDisturb,omitempty"`
+}
+
+func (x *SignalEventMessageNotificationSettingsChanged) Reset() {
+	*x = SignalEventMessageNotificationSettingsChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwm_cwmSignalMsg_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalEventMessageNotificationSettingsChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalEventMessageNotificationSettingsChanged) ProtoMessage() {}
+
+func (x *SignalEventMessageNotificationSettingsChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_cwm_cwmSignalMsg_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalEventMessageNotificationSettingsChanged.ProtoReflect.Descriptor instead.
+func (*SignalEventMessageNotificationSettingsChanged) Descriptor() ([]byte, []int) {
+	return file_cwm_cwmSignalMsg_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SignalEventMessageNotificationSettingsChanged) GetThreadSettings() []*ThreadNotificationSetting {
+	if x != nil {
+		return x.ThreadSettings
+	}
+	return nil
+}
+
+func (x *SignalEventMessageNotificationSettingsChanged) GetDoNotDisturb() *DoNotDisturbSchedule {
+	if x != nil {
+		return x.DoNotDisturb
+	}
+	return nil
+}
+
+// -------------------NOTIFICATION SETTINGS--------------------------------//
+type ThreadNotificationSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadId     string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	MutedUntil   int64  `protobuf:"varint,2,opt,name=mutedUntil,proto3" json:"mutedUntil,omitempty"`     //0 - not muted, -1 - muted until unmute, else unix milli
+	MentionsOnly bool   `protobuf:"varint,3,opt,name=mentionsOnly,proto3" json:"mentionsOnly,omitempty"` //only msgs mentioning the user show a notification
+}
+
+func (x *ThreadNotificationSetting) Reset() {
+	*x = ThreadNotificationSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwm_cwmSignalMsg_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadNotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadNotificationSetting) ProtoMessage() {}
+
+func (x *ThreadNotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_cwm_cwmSignalMsg_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadNotificationSetting.ProtoReflect.Descriptor instead.
+func (*ThreadNotificationSetting) Descriptor() ([]byte, []int) {
+	return file_cwm_cwmSignalMsg_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ThreadNotificationSetting) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *ThreadNotificationSetting) GetMutedUntil() int64 {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return 0
+}
+
+func (x *ThreadNotificationSetting) GetMentionsOnly() bool {
+	if x != nil {
+		return x.MentionsOnly
+	}
+	return false
+}
+
+type DoNotDisturbSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled     bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	StartMinute int32  `protobuf:"varint,2,opt,name=startMinute,proto3" json:"startMinute,omitempty"` //minutes from 00:00
+	EndMinute   int32  `protobuf:"varint,3,opt,name=endMinute,proto3" json:"endMinute,omitempty"`
+	Timezone    string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"` //IANA time zone, e.g. Asia/Ho_Chi_Minh
+}
+
+func (x *DoNotDisturbSchedule) Reset() {
+	*x = DoNotDisturbSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwm_cwmSignalMsg_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoNotDisturbSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoNotDisturbSchedule) ProtoMessage() {}
+
+func (x *DoNotDisturbSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_cwm_cwmSignalMsg_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoNotDisturbSchedule.ProtoReflect.Descriptor instead.
+func (*DoNotDisturbSchedule) Descriptor() ([]byte, []int) {
+	return file_cwm_cwmSignalMsg_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DoNotDisturbSchedule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DoNotDisturbSchedule) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *DoNotDisturbSchedule) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+func (x *DoNotDisturbSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 var File_cwm_cwmSignalMsg_proto protoreflect.FileDescriptor
 
 var file_cwm_cwmSignalMsg_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x63, 0x77, 0x6d, 0x2f, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d,
 	0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x22, 0xdf, 0x02, 0x0a, 0x0d, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x22, 0xfb, 0x02, 0x0a, 0x0d, 0x53, 0x69, 0x67,
 	0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73,
 	0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64,
 	0x12, 0x42, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
@@ -1469,202 +1670,236 @@ var file_cwm_cwmSignalMsg_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x16,
 	0x0a, 0x06, 0x73, 0x65, 0x65, 0x6e, 0x62, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
 	0x73, 0x65, 0x65, 0x6e, 0x62, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x51, 0x0a, 0x13, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x26, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62,
-	0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4d,
-	0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc4, 0x02,
-	0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72,
-	0x6f, 0x6d, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
-	0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c,
-	0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0a, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x69, 0x6d, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c,
-	0x5f, 0x49, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x06, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x55,
-	0x52, 0x4c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x72, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x72, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x75, 0x72, 0x6c, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x75, 0x72, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x72, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e,
-	0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc1, 0x02, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f,
-	0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67,
-	0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55,
-	0x72, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72,
-	0x69, 0x12, 0x48, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45,
-	0x44, 0x49, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x52,
-	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6f, 0x0a, 0x17, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x54, 0x0a, 0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d,
-	0x73, 0x67, 0x50, 0x62, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x46,
-	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x7f, 0x0a, 0x16,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x73, 0x65, 0x65, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x77,
+	0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47,
+	0x4e, 0x41, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc4, 0x02, 0x0a, 0x14, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66,
+	0x72, 0x6f, 0x6d, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64,
+	0x12, 0x42, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x54, 0x48, 0x52,
+	0x45, 0x41, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x4d, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x52, 0x06, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xa0, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x72, 0x6c, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x72, 0x6c, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x75, 0x72, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x72, 0x6c,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x75,
+	0x72, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x75, 0x72, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0xc1, 0x02, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
 	0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53,
-	0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x45, 0x4e, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0d, 0x73, 0x65, 0x65, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x22, 0x83, 0x03,
-	0x0a, 0x24, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x39, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50,
-	0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54,
-	0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x10, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x22, 0x6d, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63,
+	0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x12, 0x48, 0x0a,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x28, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67,
+	0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x52, 0x0a, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6f, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x54, 0x0a, 0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x7f, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x73, 0x65, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x77, 0x6d, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41,
+	0x4c, 0x5f, 0x53, 0x45, 0x45, 0x4e, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x53, 0x47, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x52, 0x0d, 0x73, 0x65, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x22, 0x83, 0x03, 0x0a, 0x24, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x63,
 	0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49,
-	0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0xd0, 0x01, 0x0a, 0x22, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4f, 0x54, 0x54, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
-	0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x1b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f,
-	0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x20, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d, 0x73, 0x67, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x6f, 0x0a,
-	0x1f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2a, 0x29,
-	0x0a, 0x12, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4f, 0x4c, 0x4f, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x01, 0x2a, 0x9e, 0x01, 0x0a, 0x0e, 0x53, 0x49,
-	0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x0a, 0x0a, 0x06,
-	0x54, 0x59, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x45, 0x4e,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45,
-	0x41, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x03, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x4d, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e,
-	0x54, 0x41, 0x43, 0x54, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4d, 0x4f, 0x54, 0x49, 0x43,
-	0x4f, 0x4e, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x10, 0x07, 0x12, 0x0e, 0x0a,
-	0x0a, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x08, 0x12, 0x0b, 0x0a,
-	0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x09, 0x2a, 0x36, 0x0a, 0x16, 0x53, 0x49,
-	0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x53, 0x47, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x49, 0x4e, 0x47,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x5f, 0x55, 0x4e, 0x54, 0x59, 0x50, 0x49, 0x4e, 0x47,
-	0x10, 0x01, 0x2a, 0x47, 0x0a, 0x11, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x44,
-	0x49, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x49, 0x44, 0x45, 0x4f,
-	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x10, 0x01, 0x12, 0x09, 0x0a,
-	0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4f, 0x43, 0x10,
-	0x03, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x04, 0x2a, 0x78, 0x0a, 0x18, 0x53,
-	0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x4e, 0x44, 0x49,
-	0x4e, 0x47, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12,
-	0x0f, 0x0a, 0x0b, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03,
-	0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41,
-	0x44, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x32, 0x0a, 0x19, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f,
-	0x53, 0x45, 0x45, 0x4e, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x53, 0x45, 0x45, 0x4e, 0x10, 0x01, 0x2a, 0xf5, 0x01, 0x0a, 0x29, 0x53, 0x49,
 	0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41,
 	0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
-	0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x52, 0x4f, 0x55, 0x50,
-	0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41,
-	0x44, 0x5f, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x52, 0x4f,
-	0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x55, 0x53,
-	0x45, 0x52, 0x53, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54,
-	0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x55, 0x53, 0x45,
-	0x52, 0x53, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48,
-	0x52, 0x45, 0x41, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x44, 0x4d,
-	0x49, 0x4e, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48,
-	0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49,
-	0x4e, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52,
-	0x45, 0x41, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10,
-	0x06, 0x2a, 0x69, 0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x54, 0x54,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x53, 0x47, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
-	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x43, 0x4c, 0x45,
-	0x41, 0x52, 0x5f, 0x4d, 0x53, 0x47, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x48, 0x52, 0x45,
-	0x41, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x42, 0x21, 0x5a, 0x1f,
-	0x73, 0x6f, 0x6c, 0x2e, 0x67, 0x6f, 0x2f, 0x63, 0x77, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22,
+	0x6d, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd0,
+	0x01, 0x0a, 0x22, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x4f, 0x54, 0x54, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75,
+	0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46,
+	0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x83, 0x01, 0x0a, 0x1b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x73, 0x67, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x20, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41,
+	0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x6f, 0x0a, 0x1f, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72,
+	0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x2d, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x0e,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x0e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x48, 0x0a, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x75, 0x72, 0x62, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x4e,
+	0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x22, 0x7b, 0x0a, 0x19, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x6e,
+	0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x44, 0x6f, 0x4e, 0x6f, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x65, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x2a, 0x29, 0x0a, 0x12, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f,
+	0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x4f, 0x4c, 0x4f, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x01,
+	0x2a, 0x9e, 0x01, 0x0a, 0x0e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x49, 0x4d, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x59, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x45, 0x45, 0x4e, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x52, 0x4f,
+	0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x4d, 0x10, 0x04,
+	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x10, 0x05, 0x12, 0x0c, 0x0a,
+	0x08, 0x45, 0x4d, 0x4f, 0x54, 0x49, 0x43, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x55,
+	0x52, 0x4c, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x4d, 0x45, 0x44,
+	0x49, 0x41, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10,
+	0x09, 0x2a, 0x36, 0x0a, 0x16, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x49,
+	0x4e, 0x47, 0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x0c, 0x0a, 0x08, 0x4d,
+	0x5f, 0x54, 0x59, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x5f, 0x55,
+	0x4e, 0x54, 0x59, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x47, 0x0a, 0x11, 0x53, 0x49, 0x47,
+	0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x09,
+	0x0a, 0x05, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x55, 0x44,
+	0x49, 0x4f, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x02, 0x12,
+	0x07, 0x0a, 0x03, 0x44, 0x4f, 0x43, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45,
+	0x10, 0x04, 0x2a, 0x78, 0x0a, 0x18, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x44,
+	0x49, 0x41, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53,
+	0x45, 0x4e, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f,
+	0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4f, 0x57, 0x4e, 0x4c,
+	0x4f, 0x41, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a,
+	0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x32, 0x0a, 0x19,
+	0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x45, 0x4e, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x45, 0x4e, 0x10, 0x01,
+	0x2a, 0xf5, 0x01, 0x0a, 0x29, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x18,
+	0x0a, 0x14, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44,
+	0x5f, 0x41, 0x44, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x4d,
+	0x4f, 0x56, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4d,
+	0x4f, 0x54, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x56, 0x4f,
+	0x4b, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x06, 0x2a, 0x8c, 0x01, 0x0a, 0x15, 0x53, 0x49, 0x47,
+	0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x54, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x53,
+	0x47, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x48,
+	0x52, 0x45, 0x41, 0x44, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x5f, 0x4d, 0x53, 0x47, 0x10, 0x02,
+	0x12, 0x12, 0x0a, 0x0e, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x53, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x04, 0x42, 0x21, 0x5a, 0x1f, 0x73, 0x6f, 0x6c, 0x2e, 0x67,
+	0x6f, 0x2f, 0x63, 0x77, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x77, 0x6d, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1680,29 +1915,32 @@ func file_cwm_cwmSignalMsg_proto_rawDescGZIP() []byte {
 }
 
 var file_cwm_cwmSignalMsg_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_cwm_cwmSignalMsg_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_cwm_cwmSignalMsg_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_cwm_cwmSignalMsg_proto_goTypes = []interface{}{
-	(SIGNAL_THREAD_TYPE)(0),                        // 0: cwmSignalMsgPb.SIGNAL_THREAD_TYPE
-	(SIGNAL_IM_TYPE)(0),                            // 1: cwmSignalMsgPb.SIGNAL_IM_TYPE
-	(SIGNAL_TYPING_MSG_TYPE)(0),                    // 2: cwmSignalMsgPb.SIGNAL_TYPING_MSG_TYPE
-	(SIGNAL_MEDIA_TYPE)(0),                         // 3: cwmSignalMsgPb.SIGNAL_MEDIA_TYPE
-	(SIGNAL_MEDIA_FILE_STATUS)(0),                  // 4: cwmSignalMsgPb.SIGNAL_MEDIA_FILE_STATUS
-	(SIGNAL_SEENSTATE_MSG_TYPE)(0),                 // 5: cwmSignalMsgPb.SIGNAL_SEENSTATE_MSG_TYPE
-	(SIGNAL_GROUP_THREAD_NOTIFICATION_MSG_TYPE)(0), // 6: cwmSignalMsgPb.SIGNAL_GROUP_THREAD_NOTIFICATION_MSG_TYPE
-	(SIGNAL_EVENT_MSG_TYPE)(0),                     // 7: cwmSignalMsgPb.SIGNAL_EVENT_MSG_TYPE
-	(*SignalMessage)(nil),                          // 8: cwmSignalMsgPb.SignalMessage
-	(*SignalTypingMessage)(nil),                    // 9: cwmSignalMsgPb.SignalTypingMessage
-	(*SignalForwardMessage)(nil),                   // 10: cwmSignalMsgPb.SignalForwardMessage
-	(*SignalURLMessage)(nil),                       // 11: cwmSignalMsgPb.SignalURLMessage
-	(*MultimediaFileInfo)(nil),                     // 12: cwmSignalMsgPb.MultimediaFileInfo
-	(*SignalMultimediaMessage)(nil),                // 13: cwmSignalMsgPb.SignalMultimediaMessage
-	(*SignalSeenStateMessage)(nil),                 // 14: cwmSignalMsgPb.SignalSeenStateMessage
-	(*SignalGroupThreadNotificationMessage)(nil),   // 15: cwmSignalMsgPb.SignalGroupThreadNotificationMessage
-	(*SignalEventMessage)(nil),                     // 16: cwmSignalMsgPb.SignalEventMessage
-	(*SignalEventMessageUpdateContactOTT)(nil),     // 17: cwmSignalMsgPb.SignalEventMessageUpdateContactOTT
-	(*SignalEventMessageMsgDelete)(nil),            // 18: cwmSignalMsgPb.SignalEventMessageMsgDelete
-	(*SignalEventMessageThreadClearMsg)(nil),       // 19: cwmSignalMsgPb.SignalEventMessageThreadClearMsg
-	(*SignalEventMessageThreadDeleted)(nil),        // 20: cwmSignalMsgPb.SignalEventMessageThreadDeleted
+	(SIGNAL_THREAD_TYPE)(0),                               // 0: cwmSignalMsgPb.SIGNAL_THREAD_TYPE
+	(SIGNAL_IM_TYPE)(0),                                   // 1: cwmSignalMsgPb.SIGNAL_IM_TYPE
+	(SIGNAL_TYPING_MSG_TYPE)(0),                           // 2: cwmSignalMsgPb.SIGNAL_TYPING_MSG_TYPE
+	(SIGNAL_MEDIA_TYPE)(0),                                // 3: cwmSignalMsgPb.SIGNAL_MEDIA_TYPE
+	(SIGNAL_MEDIA_FILE_STATUS)(0),                         // 4: cwmSignalMsgPb.SIGNAL_MEDIA_FILE_STATUS
+	(SIGNAL_SEENSTATE_MSG_TYPE)(0),                        // 5: cwmSignalMsgPb.SIGNAL_SEENSTATE_MSG_TYPE
+	(SIGNAL_GROUP_THREAD_NOTIFICATION_MSG_TYPE)(0),        // 6: cwmSignalMsgPb.SIGNAL_GROUP_THREAD_NOTIFICATION_MSG_TYPE
+	(SIGNAL_EVENT_MSG_TYPE)(0),                            // 7: cwmSignalMsgPb.SIGNAL_EVENT_MSG_TYPE
+	(*SignalMessage)(nil),                                 // 8: cwmSignalMsgPb.SignalMessage
+	(*SignalTypingMessage)(nil),                           // 9: cwmSignalMsgPb.SignalTypingMessage
+	(*SignalForwardMessage)(nil),                          // 10: cwmSignalMsgPb.SignalForwardMessage
+	(*SignalURLMessage)(nil),                              // 11: cwmSignalMsgPb.SignalURLMessage
+	(*MultimediaFileInfo)(nil),                            // 12: cwmSignalMsgPb.MultimediaFileInfo
+	(*SignalMultimediaMessage)(nil),                       // 13: cwmSignalMsgPb.SignalMultimediaMessage
+	(*SignalSeenStateMessage)(nil),                        // 14: cwmSignalMsgPb.SignalSeenStateMessage
+	(*SignalGroupThreadNotificationMessage)(nil),          // 15: cwmSignalMsgPb.SignalGroupThreadNotificationMessage
+	(*SignalEventMessage)(nil),                            // 16: cwmSignalMsgPb.SignalEventMessage
+	(*SignalEventMessageUpdateContactOTT)(nil),            // 17: cwmSignalMsgPb.SignalEventMessageUpdateContactOTT
+	(*SignalEventMessageMsgDelete)(nil),                   // 18: cwmSignalMsgPb.SignalEventMessageMsgDelete
+	(*SignalEventMessageThreadClearMsg)(nil),              // 19: cwmSignalMsgPb.SignalEventMessageThreadClearMsg
+	(*SignalEventMessageThreadDeleted)(nil),               // 20: cwmSignalMsgPb.SignalEventMessageThreadDeleted
+	(*SignalEventMessageNotificationSettingsChanged)(nil), // 21: cwmSignalMsgPb.SignalEventMessageNotificationSettingsChanged
+	(*ThreadNotificationSetting)(nil),                     // 22: cwmSignalMsgPb.ThreadNotificationSetting
+	(*DoNotDisturbSchedule)(nil),                          // 23: cwmSignalMsgPb.DoNotDisturbSchedule
 }
 var file_cwm_cwmSignalMsg_proto_depIdxs = []int32{
 	0,  // 0: cwmSignalMsgPb.SignalMessage.threadType:type_name -> cwmSignalMsgPb.SIGNAL_THREAD_TYPE
@@ -1716,11 +1954,13 @@ var file_cwm_cwmSignalMsg_proto_depIdxs = []int32{
 	5,  // 8: cwmSignalMsgPb.SignalSeenStateMessage.seenStateType:type_name -> cwmSignalMsgPb.SIGNAL_SEENSTATE_MSG_TYPE
 	6,  // 9: cwmSignalMsgPb.SignalGroupThreadNotificationMessage.notificationType:type_name -> cwmSignalMsgPb.SIGNAL_GROUP_THREAD_NOTIFICATION_MSG_TYPE
 	7,  // 10: cwmSignalMsgPb.SignalEventMessage.eventType:type_name -> cwmSignalMsgPb.SIGNAL_EVENT_MSG_TYPE
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	22, // 11: cwmSignalMsgPb.SignalEventMessageNotificationSettingsChanged.threadSettings:type_name -> cwmSignalMsgPb.ThreadNotificationSetting
+	23, // 12: cwmSignalMsgPb.SignalEventMessageNotificationSettingsChanged.doNotDisturb:type_name -> cwmSignalMsgPb.DoNotDisturbSchedule
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_cwm_cwmSignalMsg_proto_init() }
@@ -1885,6 +2125,42 @@ func file_cwm_cwmSignalMsg_proto_init() {
 				return nil
 			}
 		}
+		file_cwm_cwmSignalMsg_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalEventMessageNotificationSettingsChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cwm_cwmSignalMsg_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadNotificationSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cwm_cwmSignalMsg_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoNotDisturbSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1892,7 +2168,7 @@ func file_cwm_cwmSignalMsg_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cwm_cwmSignalMsg_proto_rawDesc,
 			NumEnums:      8,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
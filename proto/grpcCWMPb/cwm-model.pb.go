@@ -119,6 +119,108 @@ func (CONTACT_SYNC_TYPE) EnumDescriptor() ([]byte, []int) {
 	return file_grpc_cwm_model_proto_rawDescGZIP(), []int{1}
 }
 
+type MEDIA_VARIANT int32
+
+const (
+	MEDIA_VARIANT_ORIGINAL    MEDIA_VARIANT = 0
+	MEDIA_VARIANT_PLACEHOLDER MEDIA_VARIANT = 1 //tiny blurred jpeg, drawn while the image is loading
+	MEDIA_VARIANT_PREVIEW     MEDIA_VARIANT = 2 //medium jpeg, drawn in the chat bubbles
+)
+
+// Enum value maps for MEDIA_VARIANT.
+var (
+	MEDIA_VARIANT_name = map[int32]string{
+		0: "ORIGINAL",
+		1: "PLACEHOLDER",
+		2: "PREVIEW",
+	}
+	MEDIA_VARIANT_value = map[string]int32{
+		"ORIGINAL":    0,
+		"PLACEHOLDER": 1,
+		"PREVIEW":     2,
+	}
+)
+
+func (x MEDIA_VARIANT) Enum() *MEDIA_VARIANT {
+	p := new(MEDIA_VARIANT)
+	*p = x
+	return p
+}
+
+func (x MEDIA_VARIANT) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MEDIA_VARIANT) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_cwm_model_proto_enumTypes[2].Descriptor()
+}
+
+func (MEDIA_VARIANT) Type() protoreflect.EnumType {
+	return &file_grpc_cwm_model_proto_enumTypes[2]
+}
+
+func (x MEDIA_VARIANT) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MEDIA_VARIANT.Descriptor instead.
+func (MEDIA_VARIANT) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_cwm_model_proto_rawDescGZIP(), []int{2}
+}
+
+// -------------------THREAD MEDIA--------------------------------//
+type THREAD_MEDIA_TYPE int32
+
+const (
+	THREAD_MEDIA_TYPE_MEDIA  THREAD_MEDIA_TYPE = 0 //images & videos
+	THREAD_MEDIA_TYPE_FILES  THREAD_MEDIA_TYPE = 1 //docs & files
+	THREAD_MEDIA_TYPE_AUDIOS THREAD_MEDIA_TYPE = 2
+	THREAD_MEDIA_TYPE_LINKS  THREAD_MEDIA_TYPE = 3
+)
+
+// Enum value maps for THREAD_MEDIA_TYPE.
+var (
+	THREAD_MEDIA_TYPE_name = map[int32]string{
+		0: "MEDIA",
+		1: "FILES",
+		2: "AUDIOS",
+		3: "LINKS",
+	}
+	THREAD_MEDIA_TYPE_value = map[string]int32{
+		"MEDIA":  0,
+		"FILES":  1,
+		"AUDIOS": 2,
+		"LINKS":  3,
+	}
+)
+
+func (x THREAD_MEDIA_TYPE) Enum() *THREAD_MEDIA_TYPE {
+	p := new(THREAD_MEDIA_TYPE)
+	*p = x
+	return p
+}
+
+func (x THREAD_MEDIA_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (THREAD_MEDIA_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_cwm_model_proto_enumTypes[3].Descriptor()
+}
+
+func (THREAD_MEDIA_TYPE) Type() protoreflect.EnumType {
+	return &file_grpc_cwm_model_proto_enumTypes[3]
+}
+
+func (x THREAD_MEDIA_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use THREAD_MEDIA_TYPE.Descriptor instead.
+func (THREAD_MEDIA_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_cwm_model_proto_rawDescGZIP(), []int{3}
+}
+
 // -------------------PUSH TOKEN--------------------------------//
 type PUSH_TOKEN_SERVICE_TYPE int32
 
@@ -153,11 +255,11 @@ func (x PUSH_TOKEN_SERVICE_TYPE) String() string {
 }
 
 func (PUSH_TOKEN_SERVICE_TYPE) Descriptor() protoreflect.EnumDescriptor {
-	return file_grpc_cwm_model_proto_enumTypes[2].Descriptor()
+	return file_grpc_cwm_model_proto_enumTypes[4].Descriptor()
 }
 
 func (PUSH_TOKEN_SERVICE_TYPE) Type() protoreflect.EnumType {
-	return &file_grpc_cwm_model_proto_enumTypes[2]
+	return &file_grpc_cwm_model_proto_enumTypes[4]
 }
 
 func (x PUSH_TOKEN_SERVICE_TYPE) Number() protoreflect.EnumNumber {
@@ -166,7 +268,7 @@ func (x PUSH_TOKEN_SERVICE_TYPE) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PUSH_TOKEN_SERVICE_TYPE.Descriptor instead.
 func (PUSH_TOKEN_SERVICE_TYPE) EnumDescriptor() ([]byte, []int) {
-	return file_grpc_cwm_model_proto_rawDescGZIP(), []int{2}
+	return file_grpc_cwm_model_proto_rawDescGZIP(), []int{4}
 }
 
 type DeviceInfo struct {
@@ -180,6 +282,7 @@ type DeviceInfo struct {
 	Os           OS_TYPE `protobuf:"varint,4,opt,name=os,proto3,enum=grpcCWMPb.OS_TYPE" json:"os,omitempty"`
 	OsVersion    string  `protobuf:"bytes,5,opt,name=osVersion,proto3" json:"osVersion,omitempty"`
 	SessionId    *string `protobuf:"bytes,6,opt,name=sessionId,proto3,oneof" json:"sessionId,omitempty"`
+	Locale       *string `protobuf:"bytes,7,opt,name=locale,proto3,oneof" json:"locale,omitempty"` //BCP 47 language tag (e.g. en, vi-VN), notifications are rendered in this language
 }
 
 func (x *DeviceInfo) Reset() {
@@ -256,6 +359,13 @@ func (x *DeviceInfo) GetSessionId() string {
 	return ""
 }
 
+func (x *DeviceInfo) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
 type ContactInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -654,6 +764,7 @@ type MediaMsgInfo struct {
 	MsgId     string                           `protobuf:"bytes,1,opt,name=msgId,proto3" json:"msgId,omitempty"`
 	MediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE `protobuf:"varint,2,opt,name=mediaType,proto3,enum=cwmSignalMsgPb.SIGNAL_MEDIA_TYPE" json:"mediaType,omitempty"`
 	Checksum  string                           `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	ThreadId  string                           `protobuf:"bytes,4,opt,name=threadId,proto3" json:"threadId,omitempty"` //thread of the msg, only the participants of the thread can download the media
 }
 
 func (x *MediaMsgInfo) Reset() {
@@ -709,6 +820,187 @@ func (x *MediaMsgInfo) GetChecksum() string {
 	return ""
 }
 
+func (x *MediaMsgInfo) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+type ThreadMediaInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgId        string                             `protobuf:"bytes,1,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	ThreadId     string                             `protobuf:"bytes,2,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Sender       string                             `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	MediaType    THREAD_MEDIA_TYPE                  `protobuf:"varint,4,opt,name=mediaType,proto3,enum=grpcCWMPb.THREAD_MEDIA_TYPE" json:"mediaType,omitempty"`
+	FileInfo     *cwmSignalMsgPb.MultimediaFileInfo `protobuf:"bytes,5,opt,name=fileInfo,proto3" json:"fileInfo,omitempty"` //MEDIA, FILES, AUDIOS
+	Url          string                             `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`           //LINKS
+	UrlTitle     string                             `protobuf:"bytes,7,opt,name=urlTitle,proto3" json:"urlTitle,omitempty"`
+	UrlThumbnail string                             `protobuf:"bytes,8,opt,name=urlThumbnail,proto3" json:"urlThumbnail,omitempty"`
+	CreatedAt    int64                              `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"` //server date of the msg
+}
+
+func (x *ThreadMediaInfo) Reset() {
+	*x = ThreadMediaInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_model_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadMediaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadMediaInfo) ProtoMessage() {}
+
+func (x *ThreadMediaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_model_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadMediaInfo.ProtoReflect.Descriptor instead.
+func (*ThreadMediaInfo) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_model_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ThreadMediaInfo) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *ThreadMediaInfo) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *ThreadMediaInfo) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ThreadMediaInfo) GetMediaType() THREAD_MEDIA_TYPE {
+	if x != nil {
+		return x.MediaType
+	}
+	return THREAD_MEDIA_TYPE_MEDIA
+}
+
+func (x *ThreadMediaInfo) GetFileInfo() *cwmSignalMsgPb.MultimediaFileInfo {
+	if x != nil {
+		return x.FileInfo
+	}
+	return nil
+}
+
+func (x *ThreadMediaInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ThreadMediaInfo) GetUrlTitle() string {
+	if x != nil {
+		return x.UrlTitle
+	}
+	return ""
+}
+
+func (x *ThreadMediaInfo) GetUrlThumbnail() string {
+	if x != nil {
+		return x.UrlThumbnail
+	}
+	return ""
+}
+
+func (x *ThreadMediaInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type WebPushSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	P256Dh   string `protobuf:"bytes,2,opt,name=p256dh,proto3" json:"p256dh,omitempty"` //base64url
+	Auth     string `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`     //base64url
+}
+
+func (x *WebPushSubscription) Reset() {
+	*x = WebPushSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_model_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebPushSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushSubscription) ProtoMessage() {}
+
+func (x *WebPushSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_model_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushSubscription.ProtoReflect.Descriptor instead.
+func (*WebPushSubscription) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_model_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WebPushSubscription) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WebPushSubscription) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
+}
+
+func (x *WebPushSubscription) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
 type PushTokenInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -718,12 +1010,13 @@ type PushTokenInfo struct {
 	PushtokenID          string                  `protobuf:"bytes,2,opt,name=pushtokenID,proto3" json:"pushtokenID,omitempty"`
 	Appid                string                  `protobuf:"bytes,3,opt,name=appid,proto3" json:"appid,omitempty"`
 	Bundleid             string                  `protobuf:"bytes,4,opt,name=bundleid,proto3" json:"bundleid,omitempty"`
+	Locale               *string                 `protobuf:"bytes,5,opt,name=locale,proto3,oneof" json:"locale,omitempty"` //updates the session's locale if set
 }
 
 func (x *PushTokenInfo) Reset() {
 	*x = PushTokenInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_model_proto_msgTypes[6]
+		mi := &file_grpc_cwm_model_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -736,7 +1029,7 @@ func (x *PushTokenInfo) String() string {
 func (*PushTokenInfo) ProtoMessage() {}
 
 func (x *PushTokenInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_model_proto_msgTypes[6]
+	mi := &file_grpc_cwm_model_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,7 +1042,7 @@ func (x *PushTokenInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushTokenInfo.ProtoReflect.Descriptor instead.
 func (*PushTokenInfo) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_model_proto_rawDescGZIP(), []int{6}
+	return file_grpc_cwm_model_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PushTokenInfo) GetPushTokenServiceType() PUSH_TOKEN_SERVICE_TYPE {
@@ -780,13 +1073,20 @@ func (x *PushTokenInfo) GetBundleid() string {
 	return ""
 }
 
+func (x *PushTokenInfo) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
 var File_grpc_cwm_model_proto protoreflect.FileDescriptor
 
 var file_grpc_cwm_model_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x77, 0x6d, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50,
 	0x62, 0x1a, 0x16, 0x63, 0x77, 0x6d, 0x2f, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x4d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x44, 0x65,
+	0x4d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x01, 0x0a, 0x0a, 0x44, 0x65,
 	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69,
 	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
 	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x65, 0x69,
@@ -799,112 +1099,153 @@ var file_grpc_cwm_model_proto_rawDesc = []byte{
 	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
 	0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x22, 0x92, 0x03, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75,
-	0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46,
-	0x75, 0x6c, 0x6c, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50,
-	0x62, 0x2e, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x48, 0x00, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x1f, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x02, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x23, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74,
-	0x61, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x08, 0x6c, 0x61,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x79,
-	0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
-	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
-	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x15, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61,
-	0x74, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd7, 0x02,
-	0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x42, 0x0a,
-	0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x22, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67,
-	0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73,
-	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e,
-	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x64, 0x69,
-	0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x3f,
-	0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67,
-	0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0xbb, 0x01, 0x0a, 0x0d,
-	0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x56, 0x0a,
-	0x14, 0x70, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x72,
-	0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b,
-	0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52,
-	0x14, 0x70, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x69, 0x64, 0x2a, 0x2b, 0x0a, 0x07, 0x4f, 0x53, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x45,
-	0x42, 0x41, 0x50, 0x50, 0x10, 0x02, 0x2a, 0x34, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43,
-	0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x07, 0x0a, 0x03, 0x41,
-	0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01,
-	0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x02, 0x2a, 0x42, 0x0a, 0x17,
-	0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
-	0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x43, 0x4d, 0x10, 0x00,
-	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x50, 0x4e, 0x53, 0x5f, 0x56, 0x4f, 0x49, 0x50, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x41, 0x50, 0x4e, 0x53, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x02,
-	0x42, 0x1c, 0x5a, 0x1a, 0x73, 0x6f, 0x6c, 0x2e, 0x67, 0x6f, 0x2f, 0x63, 0x77, 0x6d, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x92, 0x03, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x3d, 0x0a, 0x08, 0x73,
+	0x79, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43,
+	0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x79, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x05, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0xbc, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xc3, 0x01, 0x0a, 0x15, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd7, 0x02, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x77, 0x6d, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41,
+	0x4c, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0a, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22,
+	0x9d, 0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x77, 0x6d, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x53, 0x49, 0x47, 0x4e, 0x41,
+	0x4c, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22,
+	0xc7, 0x02, 0x0a, 0x0f, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a,
+	0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x54, 0x48, 0x52,
+	0x45, 0x41, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x77,
+	0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x72, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x72, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x54, 0x68,
+	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75,
+	0x72, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5d, 0x0a, 0x13, 0x57, 0x65, 0x62,
+	0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x32, 0x35, 0x36, 0x64, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x32,
+	0x35, 0x36, 0x64, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0xe3, 0x01, 0x0a, 0x0d, 0x50, 0x75, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x56, 0x0a, 0x14, 0x70, 0x75,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43,
+	0x57, 0x4d, 0x50, 0x62, 0x2e, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f,
+	0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x14, 0x70, 0x75,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x2a, 0x2b,
+	0x0a, 0x07, 0x4f, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x44,
+	0x52, 0x4f, 0x49, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x53, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x57, 0x45, 0x42, 0x41, 0x50, 0x50, 0x10, 0x02, 0x2a, 0x34, 0x0a, 0x11, 0x43,
+	0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10,
+	0x02, 0x2a, 0x3b, 0x0a, 0x0d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41,
+	0x4e, 0x54, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x48, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x02, 0x2a, 0x40,
+	0x0a, 0x11, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x55, 0x44,
+	0x49, 0x4f, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x10, 0x03,
+	0x2a, 0x42, 0x0a, 0x17, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x07, 0x0a, 0x03, 0x46,
+	0x43, 0x4d, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x50, 0x4e, 0x53, 0x5f, 0x56, 0x4f, 0x49,
+	0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x50, 0x4e, 0x53, 0x5f, 0x52, 0x45, 0x4d, 0x4f,
+	0x54, 0x45, 0x10, 0x02, 0x42, 0x1c, 0x5a, 0x1a, 0x73, 0x6f, 0x6c, 0x2e, 0x67, 0x6f, 0x2f, 0x63,
+	0x77, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d,
+	0x50, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -919,34 +1260,41 @@ func file_grpc_cwm_model_proto_rawDescGZIP() []byte {
 	return file_grpc_cwm_model_proto_rawDescData
 }
 
-var file_grpc_cwm_model_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_grpc_cwm_model_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_grpc_cwm_model_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_grpc_cwm_model_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_grpc_cwm_model_proto_goTypes = []interface{}{
-	(OS_TYPE)(0),                           // 0: grpcCWMPb.OS_TYPE
-	(CONTACT_SYNC_TYPE)(0),                 // 1: grpcCWMPb.CONTACT_SYNC_TYPE
-	(PUSH_TOKEN_SERVICE_TYPE)(0),           // 2: grpcCWMPb.PUSH_TOKEN_SERVICE_TYPE
-	(*DeviceInfo)(nil),                     // 3: grpcCWMPb.DeviceInfo
-	(*ContactInfo)(nil),                    // 4: grpcCWMPb.ContactInfo
-	(*SearchUserInfo)(nil),                 // 5: grpcCWMPb.SearchUserInfo
-	(*ThreadParticipantInfo)(nil),          // 6: grpcCWMPb.ThreadParticipantInfo
-	(*GroupThreadInfo)(nil),                // 7: grpcCWMPb.GroupThreadInfo
-	(*MediaMsgInfo)(nil),                   // 8: grpcCWMPb.MediaMsgInfo
-	(*PushTokenInfo)(nil),                  // 9: grpcCWMPb.PushTokenInfo
-	(cwmSignalMsgPb.SIGNAL_THREAD_TYPE)(0), // 10: cwmSignalMsgPb.SIGNAL_THREAD_TYPE
-	(cwmSignalMsgPb.SIGNAL_MEDIA_TYPE)(0),  // 11: cwmSignalMsgPb.SIGNAL_MEDIA_TYPE
+	(OS_TYPE)(0),                              // 0: grpcCWMPb.OS_TYPE
+	(CONTACT_SYNC_TYPE)(0),                    // 1: grpcCWMPb.CONTACT_SYNC_TYPE
+	(MEDIA_VARIANT)(0),                        // 2: grpcCWMPb.MEDIA_VARIANT
+	(THREAD_MEDIA_TYPE)(0),                    // 3: grpcCWMPb.THREAD_MEDIA_TYPE
+	(PUSH_TOKEN_SERVICE_TYPE)(0),              // 4: grpcCWMPb.PUSH_TOKEN_SERVICE_TYPE
+	(*DeviceInfo)(nil),                        // 5: grpcCWMPb.DeviceInfo
+	(*ContactInfo)(nil),                       // 6: grpcCWMPb.ContactInfo
+	(*SearchUserInfo)(nil),                    // 7: grpcCWMPb.SearchUserInfo
+	(*ThreadParticipantInfo)(nil),             // 8: grpcCWMPb.ThreadParticipantInfo
+	(*GroupThreadInfo)(nil),                   // 9: grpcCWMPb.GroupThreadInfo
+	(*MediaMsgInfo)(nil),                      // 10: grpcCWMPb.MediaMsgInfo
+	(*ThreadMediaInfo)(nil),                   // 11: grpcCWMPb.ThreadMediaInfo
+	(*WebPushSubscription)(nil),               // 12: grpcCWMPb.WebPushSubscription
+	(*PushTokenInfo)(nil),                     // 13: grpcCWMPb.PushTokenInfo
+	(cwmSignalMsgPb.SIGNAL_THREAD_TYPE)(0),    // 14: cwmSignalMsgPb.SIGNAL_THREAD_TYPE
+	(cwmSignalMsgPb.SIGNAL_MEDIA_TYPE)(0),     // 15: cwmSignalMsgPb.SIGNAL_MEDIA_TYPE
+	(*cwmSignalMsgPb.MultimediaFileInfo)(nil), // 16: cwmSignalMsgPb.MultimediaFileInfo
 }
 var file_grpc_cwm_model_proto_depIdxs = []int32{
 	0,  // 0: grpcCWMPb.DeviceInfo.os:type_name -> grpcCWMPb.OS_TYPE
 	1,  // 1: grpcCWMPb.ContactInfo.syncType:type_name -> grpcCWMPb.CONTACT_SYNC_TYPE
-	10, // 2: grpcCWMPb.GroupThreadInfo.threadType:type_name -> cwmSignalMsgPb.SIGNAL_THREAD_TYPE
-	6,  // 3: grpcCWMPb.GroupThreadInfo.participantInfos:type_name -> grpcCWMPb.ThreadParticipantInfo
-	11, // 4: grpcCWMPb.MediaMsgInfo.mediaType:type_name -> cwmSignalMsgPb.SIGNAL_MEDIA_TYPE
-	2,  // 5: grpcCWMPb.PushTokenInfo.pushTokenServiceType:type_name -> grpcCWMPb.PUSH_TOKEN_SERVICE_TYPE
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	14, // 2: grpcCWMPb.GroupThreadInfo.threadType:type_name -> cwmSignalMsgPb.SIGNAL_THREAD_TYPE
+	8,  // 3: grpcCWMPb.GroupThreadInfo.participantInfos:type_name -> grpcCWMPb.ThreadParticipantInfo
+	15, // 4: grpcCWMPb.MediaMsgInfo.mediaType:type_name -> cwmSignalMsgPb.SIGNAL_MEDIA_TYPE
+	3,  // 5: grpcCWMPb.ThreadMediaInfo.mediaType:type_name -> grpcCWMPb.THREAD_MEDIA_TYPE
+	16, // 6: grpcCWMPb.ThreadMediaInfo.fileInfo:type_name -> cwmSignalMsgPb.MultimediaFileInfo
+	4,  // 7: grpcCWMPb.PushTokenInfo.pushTokenServiceType:type_name -> grpcCWMPb.PUSH_TOKEN_SERVICE_TYPE
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_grpc_cwm_model_proto_init() }
@@ -1028,6 +1376,30 @@ func file_grpc_cwm_model_proto_init() {
 			}
 		}
 		file_grpc_cwm_model_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadMediaInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_model_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebPushSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_model_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushTokenInfo); i {
 			case 0:
 				return &v.state
@@ -1042,13 +1414,14 @@ func file_grpc_cwm_model_proto_init() {
 	}
 	file_grpc_cwm_model_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_grpc_cwm_model_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_grpc_cwm_model_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_cwm_model_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
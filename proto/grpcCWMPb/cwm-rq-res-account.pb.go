@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	cwmSignalMsgPb "sol.go/cwm/proto/cwmSignalMsgPb"
 	sync "sync"
 )
 
@@ -636,6 +637,143 @@ func (x *UpdateProfileResponse) GetLastName() string {
 	return ""
 }
 
+// -------------------UPLOAD AVATAR--------------------------------//
+type UploadAvatarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//
+	//	*UploadAvatarRequest_Checksum
+	//	*UploadAvatarRequest_ChunkData
+	Data isUploadAvatarRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *UploadAvatarRequest) Reset() {
+	*x = UploadAvatarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarRequest) ProtoMessage() {}
+
+func (x *UploadAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *UploadAvatarRequest) GetData() isUploadAvatarRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadAvatarRequest) GetChecksum() string {
+	if x, ok := x.GetData().(*UploadAvatarRequest_Checksum); ok {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *UploadAvatarRequest) GetChunkData() []byte {
+	if x, ok := x.GetData().(*UploadAvatarRequest_ChunkData); ok {
+		return x.ChunkData
+	}
+	return nil
+}
+
+type isUploadAvatarRequest_Data interface {
+	isUploadAvatarRequest_Data()
+}
+
+type UploadAvatarRequest_Checksum struct {
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3,oneof"`
+}
+
+type UploadAvatarRequest_ChunkData struct {
+	ChunkData []byte `protobuf:"bytes,2,opt,name=chunk_data,json=chunkData,proto3,oneof"`
+}
+
+func (*UploadAvatarRequest_Checksum) isUploadAvatarRequest_Data() {}
+
+func (*UploadAvatarRequest_ChunkData) isUploadAvatarRequest_Data() {}
+
+type UploadAvatarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Avatar string `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"` //fileId of the avatar
+	MsgId  string `protobuf:"bytes,2,opt,name=msgId,proto3" json:"msgId,omitempty"`   //msgId to download the avatar with DownloadMediaMsg
+}
+
+func (x *UploadAvatarResponse) Reset() {
+	*x = UploadAvatarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarResponse) ProtoMessage() {}
+
+func (x *UploadAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UploadAvatarResponse) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UploadAvatarResponse) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
 // -------------------UPDATE USERNAME--------------------------------//
 type UpdateUsernameRequest struct {
 	state         protoimpl.MessageState
@@ -648,7 +786,7 @@ type UpdateUsernameRequest struct {
 func (x *UpdateUsernameRequest) Reset() {
 	*x = UpdateUsernameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[10]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -661,7 +799,7 @@ func (x *UpdateUsernameRequest) String() string {
 func (*UpdateUsernameRequest) ProtoMessage() {}
 
 func (x *UpdateUsernameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[10]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +812,7 @@ func (x *UpdateUsernameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUsernameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUsernameRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{10}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateUsernameRequest) GetUserName() string {
@@ -695,7 +833,7 @@ type UpdateUsernameResponse struct {
 func (x *UpdateUsernameResponse) Reset() {
 	*x = UpdateUsernameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[11]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -708,7 +846,7 @@ func (x *UpdateUsernameResponse) String() string {
 func (*UpdateUsernameResponse) ProtoMessage() {}
 
 func (x *UpdateUsernameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[11]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +859,7 @@ func (x *UpdateUsernameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUsernameResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUsernameResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{11}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateUsernameResponse) GetUserName() string {
@@ -743,7 +881,7 @@ type SearchByUsernameRequest struct {
 func (x *SearchByUsernameRequest) Reset() {
 	*x = SearchByUsernameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[12]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -756,7 +894,7 @@ func (x *SearchByUsernameRequest) String() string {
 func (*SearchByUsernameRequest) ProtoMessage() {}
 
 func (x *SearchByUsernameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[12]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,7 +907,7 @@ func (x *SearchByUsernameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchByUsernameRequest.ProtoReflect.Descriptor instead.
 func (*SearchByUsernameRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{12}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SearchByUsernameRequest) GetUserName() string {
@@ -790,7 +928,7 @@ type SearchByUsernameResponse struct {
 func (x *SearchByUsernameResponse) Reset() {
 	*x = SearchByUsernameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[13]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -803,7 +941,7 @@ func (x *SearchByUsernameResponse) String() string {
 func (*SearchByUsernameResponse) ProtoMessage() {}
 
 func (x *SearchByUsernameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[13]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,7 +954,7 @@ func (x *SearchByUsernameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchByUsernameResponse.ProtoReflect.Descriptor instead.
 func (*SearchByUsernameResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{13}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SearchByUsernameResponse) GetSearchUserInfos() []*SearchUserInfo {
@@ -838,7 +976,7 @@ type SearchByPhoneFullRequest struct {
 func (x *SearchByPhoneFullRequest) Reset() {
 	*x = SearchByPhoneFullRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[14]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -851,7 +989,7 @@ func (x *SearchByPhoneFullRequest) String() string {
 func (*SearchByPhoneFullRequest) ProtoMessage() {}
 
 func (x *SearchByPhoneFullRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[14]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +1002,7 @@ func (x *SearchByPhoneFullRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchByPhoneFullRequest.ProtoReflect.Descriptor instead.
 func (*SearchByPhoneFullRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{14}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SearchByPhoneFullRequest) GetPhoneFull() string {
@@ -885,7 +1023,7 @@ type SearchByPhoneFullResponse struct {
 func (x *SearchByPhoneFullResponse) Reset() {
 	*x = SearchByPhoneFullResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[15]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -898,7 +1036,7 @@ func (x *SearchByPhoneFullResponse) String() string {
 func (*SearchByPhoneFullResponse) ProtoMessage() {}
 
 func (x *SearchByPhoneFullResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[15]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,7 +1049,7 @@ func (x *SearchByPhoneFullResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchByPhoneFullResponse.ProtoReflect.Descriptor instead.
 func (*SearchByPhoneFullResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{15}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SearchByPhoneFullResponse) GetSearchUserInfos() []*SearchUserInfo {
@@ -933,7 +1071,7 @@ type FindByListPhoneFullRequest struct {
 func (x *FindByListPhoneFullRequest) Reset() {
 	*x = FindByListPhoneFullRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[16]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -946,7 +1084,7 @@ func (x *FindByListPhoneFullRequest) String() string {
 func (*FindByListPhoneFullRequest) ProtoMessage() {}
 
 func (x *FindByListPhoneFullRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[16]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1097,7 @@ func (x *FindByListPhoneFullRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindByListPhoneFullRequest.ProtoReflect.Descriptor instead.
 func (*FindByListPhoneFullRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{16}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *FindByListPhoneFullRequest) GetPhoneFulls() []string {
@@ -980,7 +1118,7 @@ type FindByListPhoneFullResponse struct {
 func (x *FindByListPhoneFullResponse) Reset() {
 	*x = FindByListPhoneFullResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[17]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -993,7 +1131,7 @@ func (x *FindByListPhoneFullResponse) String() string {
 func (*FindByListPhoneFullResponse) ProtoMessage() {}
 
 func (x *FindByListPhoneFullResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[17]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1006,7 +1144,7 @@ func (x *FindByListPhoneFullResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindByListPhoneFullResponse.ProtoReflect.Descriptor instead.
 func (*FindByListPhoneFullResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{17}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *FindByListPhoneFullResponse) GetSearchUserInfos() []*SearchUserInfo {
@@ -1028,7 +1166,7 @@ type UpdatePushTokenRequest struct {
 func (x *UpdatePushTokenRequest) Reset() {
 	*x = UpdatePushTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[18]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1041,7 +1179,7 @@ func (x *UpdatePushTokenRequest) String() string {
 func (*UpdatePushTokenRequest) ProtoMessage() {}
 
 func (x *UpdatePushTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[18]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +1192,7 @@ func (x *UpdatePushTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePushTokenRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePushTokenRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{18}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdatePushTokenRequest) GetPushTokenInfo() *PushTokenInfo {
@@ -1075,7 +1213,7 @@ type UpdatePushTokenResponse struct {
 func (x *UpdatePushTokenResponse) Reset() {
 	*x = UpdatePushTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[19]
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1088,7 +1226,7 @@ func (x *UpdatePushTokenResponse) String() string {
 func (*UpdatePushTokenResponse) ProtoMessage() {}
 
 func (x *UpdatePushTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[19]
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1239,7 @@ func (x *UpdatePushTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePushTokenResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePushTokenResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{19}
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UpdatePushTokenResponse) GetPushTokenInfo() *PushTokenInfo {
@@ -1111,234 +1249,924 @@ func (x *UpdatePushTokenResponse) GetPushTokenInfo() *PushTokenInfo {
 	return nil
 }
 
-var File_grpc_cwm_rq_res_account_proto protoreflect.FileDescriptor
+// -------------------WEB PUSH--------------------------------//
+type UpdateWebPushSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_grpc_cwm_rq_res_account_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x77, 0x6d, 0x2d, 0x72, 0x71, 0x2d, 0x72, 0x65,
-	0x73, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x09, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x1a, 0x14, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x63, 0x77, 0x6d, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x4d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22,
-	0x86, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0xa8, 0x01, 0x0a, 0x17, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x0a,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x76,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x76, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x7c, 0x0a,
-	0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6a, 0x77, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x6a, 0x77, 0x74, 0x54, 0x54, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x6a, 0x77, 0x74, 0x54, 0x54, 0x4c, 0x22, 0x4e, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x4f, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x50, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x15, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x15,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5f,
-	0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22,
-	0x38, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65,
-	0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x22, 0x60, 0x0a, 0x19, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x42, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x3c, 0x0a, 0x1a, 0x46,
-	0x69, 0x6e, 0x64, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x73, 0x22, 0x62, 0x0a, 0x1b, 0x46, 0x69, 0x6e,
-	0x64, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x58, 0x0a,
-	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x59, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x42, 0x1c, 0x5a, 0x1a, 0x73, 0x6f, 0x6c, 0x2e, 0x67, 0x6f, 0x2f, 0x63, 0x77, 0x6d,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Subscription *WebPushSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Locale       *string              `protobuf:"bytes,2,opt,name=locale,proto3,oneof" json:"locale,omitempty"` //updates the session's locale if set
 }
 
-var (
-	file_grpc_cwm_rq_res_account_proto_rawDescOnce sync.Once
-	file_grpc_cwm_rq_res_account_proto_rawDescData = file_grpc_cwm_rq_res_account_proto_rawDesc
-)
+func (x *UpdateWebPushSubscriptionRequest) Reset() {
+	*x = UpdateWebPushSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_grpc_cwm_rq_res_account_proto_rawDescGZIP() []byte {
-	file_grpc_cwm_rq_res_account_proto_rawDescOnce.Do(func() {
-		file_grpc_cwm_rq_res_account_proto_rawDescData = protoimpl.X.CompressGZIP(file_grpc_cwm_rq_res_account_proto_rawDescData)
-	})
-	return file_grpc_cwm_rq_res_account_proto_rawDescData
+func (x *UpdateWebPushSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_grpc_cwm_rq_res_account_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_grpc_cwm_rq_res_account_proto_goTypes = []interface{}{
-	(*CreatAccountRequest)(nil),         // 0: grpcCWMPb.CreatAccountRequest
-	(*CreatAccountResponse)(nil),        // 1: grpcCWMPb.CreatAccountResponse
-	(*VerifyAuthencodeRequest)(nil),     // 2: grpcCWMPb.VerifyAuthencodeRequest
-	(*VerifyAuthencodeResponse)(nil),    // 3: grpcCWMPb.VerifyAuthencodeResponse
-	(*LoginRequest)(nil),                // 4: grpcCWMPb.LoginRequest
-	(*LoginResponse)(nil),               // 5: grpcCWMPb.LoginResponse
-	(*SyncContactRequest)(nil),          // 6: grpcCWMPb.SyncContactRequest
-	(*SyncContactResponse)(nil),         // 7: grpcCWMPb.SyncContactResponse
-	(*UpdateProfileRequest)(nil),        // 8: grpcCWMPb.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),       // 9: grpcCWMPb.UpdateProfileResponse
-	(*UpdateUsernameRequest)(nil),       // 10: grpcCWMPb.UpdateUsernameRequest
-	(*UpdateUsernameResponse)(nil),      // 11: grpcCWMPb.UpdateUsernameResponse
-	(*SearchByUsernameRequest)(nil),     // 12: grpcCWMPb.SearchByUsernameRequest
-	(*SearchByUsernameResponse)(nil),    // 13: grpcCWMPb.SearchByUsernameResponse
-	(*SearchByPhoneFullRequest)(nil),    // 14: grpcCWMPb.SearchByPhoneFullRequest
-	(*SearchByPhoneFullResponse)(nil),   // 15: grpcCWMPb.SearchByPhoneFullResponse
-	(*FindByListPhoneFullRequest)(nil),  // 16: grpcCWMPb.FindByListPhoneFullRequest
-	(*FindByListPhoneFullResponse)(nil), // 17: grpcCWMPb.FindByListPhoneFullResponse
-	(*UpdatePushTokenRequest)(nil),      // 18: grpcCWMPb.UpdatePushTokenRequest
-	(*UpdatePushTokenResponse)(nil),     // 19: grpcCWMPb.UpdatePushTokenResponse
-	(*DeviceInfo)(nil),                  // 20: grpcCWMPb.DeviceInfo
-	(*ContactInfo)(nil),                 // 21: grpcCWMPb.ContactInfo
-	(*SearchUserInfo)(nil),              // 22: grpcCWMPb.SearchUserInfo
-	(*PushTokenInfo)(nil),               // 23: grpcCWMPb.PushTokenInfo
+func (*UpdateWebPushSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateWebPushSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_grpc_cwm_rq_res_account_proto_depIdxs = []int32{
-	20, // 0: grpcCWMPb.VerifyAuthencodeRequest.deviceInfo:type_name -> grpcCWMPb.DeviceInfo
-	20, // 1: grpcCWMPb.VerifyAuthencodeResponse.deviceInfo:type_name -> grpcCWMPb.DeviceInfo
-	21, // 2: grpcCWMPb.SyncContactRequest.contactInfo:type_name -> grpcCWMPb.ContactInfo
-	21, // 3: grpcCWMPb.SyncContactResponse.contactInfo:type_name -> grpcCWMPb.ContactInfo
-	22, // 4: grpcCWMPb.SearchByUsernameResponse.searchUserInfos:type_name -> grpcCWMPb.SearchUserInfo
-	22, // 5: grpcCWMPb.SearchByPhoneFullResponse.searchUserInfos:type_name -> grpcCWMPb.SearchUserInfo
-	22, // 6: grpcCWMPb.FindByListPhoneFullResponse.searchUserInfos:type_name -> grpcCWMPb.SearchUserInfo
-	23, // 7: grpcCWMPb.UpdatePushTokenRequest.pushTokenInfo:type_name -> grpcCWMPb.PushTokenInfo
-	23, // 8: grpcCWMPb.UpdatePushTokenResponse.pushTokenInfo:type_name -> grpcCWMPb.PushTokenInfo
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+// Deprecated: Use UpdateWebPushSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebPushSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{22}
 }
 
-func init() { file_grpc_cwm_rq_res_account_proto_init() }
-func file_grpc_cwm_rq_res_account_proto_init() {
-	if File_grpc_cwm_rq_res_account_proto != nil {
-		return
+func (x *UpdateWebPushSubscriptionRequest) GetSubscription() *WebPushSubscription {
+	if x != nil {
+		return x.Subscription
 	}
-	file_grpc_cwm_model_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_grpc_cwm_rq_res_account_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatAccountRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_grpc_cwm_rq_res_account_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatAccountResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *UpdateWebPushSubscriptionRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type UpdateWebPushSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *WebPushSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *UpdateWebPushSubscriptionResponse) Reset() {
+	*x = UpdateWebPushSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWebPushSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebPushSubscriptionResponse) ProtoMessage() {}
+
+func (x *UpdateWebPushSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_grpc_cwm_rq_res_account_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyAuthencodeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebPushSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebPushSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateWebPushSubscriptionResponse) GetSubscription() *WebPushSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type GetWebPushConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetWebPushConfigRequest) Reset() {
+	*x = GetWebPushConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebPushConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebPushConfigRequest) ProtoMessage() {}
+
+func (x *GetWebPushConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebPushConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetWebPushConfigRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{24}
+}
+
+type GetWebPushConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VapidPublicKey string `protobuf:"bytes,1,opt,name=vapidPublicKey,proto3" json:"vapidPublicKey,omitempty"` //base64url, the applicationServerKey of PushManager.subscribe
+}
+
+func (x *GetWebPushConfigResponse) Reset() {
+	*x = GetWebPushConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebPushConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebPushConfigResponse) ProtoMessage() {}
+
+func (x *GetWebPushConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebPushConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetWebPushConfigResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetWebPushConfigResponse) GetVapidPublicKey() string {
+	if x != nil {
+		return x.VapidPublicKey
+	}
+	return ""
+}
+
+// -------------------NOTIFICATION SETTINGS--------------------------------//
+type MuteThreadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadId   string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	MutedUntil int64  `protobuf:"varint,2,opt,name=mutedUntil,proto3" json:"mutedUntil,omitempty"` //0 - unmute, -1 - mute until unmute, else unix milli
+}
+
+func (x *MuteThreadRequest) Reset() {
+	*x = MuteThreadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteThreadRequest) ProtoMessage() {}
+
+func (x *MuteThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteThreadRequest.ProtoReflect.Descriptor instead.
+func (*MuteThreadRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MuteThreadRequest) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MuteThreadRequest) GetMutedUntil() int64 {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return 0
+}
+
+type MuteThreadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadSetting *cwmSignalMsgPb.ThreadNotificationSetting `protobuf:"bytes,1,opt,name=threadSetting,proto3" json:"threadSetting,omitempty"`
+}
+
+func (x *MuteThreadResponse) Reset() {
+	*x = MuteThreadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteThreadResponse) ProtoMessage() {}
+
+func (x *MuteThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteThreadResponse.ProtoReflect.Descriptor instead.
+func (*MuteThreadResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MuteThreadResponse) GetThreadSetting() *cwmSignalMsgPb.ThreadNotificationSetting {
+	if x != nil {
+		return x.ThreadSetting
+	}
+	return nil
+}
+
+type SetThreadMentionsOnlyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadId     string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	MentionsOnly bool   `protobuf:"varint,2,opt,name=mentionsOnly,proto3" json:"mentionsOnly,omitempty"`
+}
+
+func (x *SetThreadMentionsOnlyRequest) Reset() {
+	*x = SetThreadMentionsOnlyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetThreadMentionsOnlyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetThreadMentionsOnlyRequest) ProtoMessage() {}
+
+func (x *SetThreadMentionsOnlyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetThreadMentionsOnlyRequest.ProtoReflect.Descriptor instead.
+func (*SetThreadMentionsOnlyRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetThreadMentionsOnlyRequest) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *SetThreadMentionsOnlyRequest) GetMentionsOnly() bool {
+	if x != nil {
+		return x.MentionsOnly
+	}
+	return false
+}
+
+type SetThreadMentionsOnlyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadSetting *cwmSignalMsgPb.ThreadNotificationSetting `protobuf:"bytes,1,opt,name=threadSetting,proto3" json:"threadSetting,omitempty"`
+}
+
+func (x *SetThreadMentionsOnlyResponse) Reset() {
+	*x = SetThreadMentionsOnlyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetThreadMentionsOnlyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetThreadMentionsOnlyResponse) ProtoMessage() {}
+
+func (x *SetThreadMentionsOnlyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetThreadMentionsOnlyResponse.ProtoReflect.Descriptor instead.
+func (*SetThreadMentionsOnlyResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetThreadMentionsOnlyResponse) GetThreadSetting() *cwmSignalMsgPb.ThreadNotificationSetting {
+	if x != nil {
+		return x.ThreadSetting
+	}
+	return nil
+}
+
+type UpdateDoNotDisturbRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DoNotDisturb *cwmSignalMsgPb.DoNotDisturbSchedule `protobuf:"bytes,1,opt,name=doNotDisturb,proto3" json:"doNotDisturb,omitempty"`
+}
+
+func (x *UpdateDoNotDisturbRequest) Reset() {
+	*x = UpdateDoNotDisturbRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDoNotDisturbRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDoNotDisturbRequest) ProtoMessage() {}
+
+func (x *UpdateDoNotDisturbRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDoNotDisturbRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDoNotDisturbRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateDoNotDisturbRequest) GetDoNotDisturb() *cwmSignalMsgPb.DoNotDisturbSchedule {
+	if x != nil {
+		return x.DoNotDisturb
+	}
+	return nil
+}
+
+type UpdateDoNotDisturbResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DoNotDisturb *cwmSignalMsgPb.DoNotDisturbSchedule `protobuf:"bytes,1,opt,name=doNotDisturb,proto3" json:"doNotDisturb,omitempty"`
+}
+
+func (x *UpdateDoNotDisturbResponse) Reset() {
+	*x = UpdateDoNotDisturbResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDoNotDisturbResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDoNotDisturbResponse) ProtoMessage() {}
+
+func (x *UpdateDoNotDisturbResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDoNotDisturbResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDoNotDisturbResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateDoNotDisturbResponse) GetDoNotDisturb() *cwmSignalMsgPb.DoNotDisturbSchedule {
+	if x != nil {
+		return x.DoNotDisturb
+	}
+	return nil
+}
+
+type GetNotificationSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNotificationSettingsRequest) Reset() {
+	*x = GetNotificationSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettingsRequest) ProtoMessage() {}
+
+func (x *GetNotificationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{32}
+}
+
+type GetNotificationSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadSettings []*cwmSignalMsgPb.ThreadNotificationSetting `protobuf:"bytes,1,rep,name=threadSettings,proto3" json:"threadSettings,omitempty"`
+	DoNotDisturb   *cwmSignalMsgPb.DoNotDisturbSchedule        `protobuf:"bytes,2,opt,name=doNotDisturb,proto3" json:"doNotDisturb,omitempty"`
+}
+
+func (x *GetNotificationSettingsResponse) Reset() {
+	*x = GetNotificationSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettingsResponse) ProtoMessage() {}
+
+func (x *GetNotificationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_account_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_account_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetNotificationSettingsResponse) GetThreadSettings() []*cwmSignalMsgPb.ThreadNotificationSetting {
+	if x != nil {
+		return x.ThreadSettings
+	}
+	return nil
+}
+
+func (x *GetNotificationSettingsResponse) GetDoNotDisturb() *cwmSignalMsgPb.DoNotDisturbSchedule {
+	if x != nil {
+		return x.DoNotDisturb
+	}
+	return nil
+}
+
+var File_grpc_cwm_rq_res_account_proto protoreflect.FileDescriptor
+
+var file_grpc_cwm_rq_res_account_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x77, 0x6d, 0x2d, 0x72, 0x71, 0x2d, 0x72, 0x65,
+	0x73, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x09, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x1a, 0x14, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x63, 0x77, 0x6d, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x16, 0x63, 0x77, 0x6d, 0x2f, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d,
+	0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2c,
+	0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x4f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x22, 0xa8, 0x01, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43,
+	0x57, 0x4d, 0x50, 0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73,
+	0x45, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x45,
+	0x6e, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
+	0x69, 0x76, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d,
+	0x50, 0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x7c, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75,
+	0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46,
+	0x75, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x77, 0x74, 0x54, 0x54, 0x4c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6a, 0x77, 0x74, 0x54, 0x54, 0x4c, 0x22, 0x4e,
+	0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x4f,
+	0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x50, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x51, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x08, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0a, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x44, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x18, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x38, 0x0a, 0x18, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x46, 0x75, 0x6c, 0x6c, 0x22, 0x60, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42,
+	0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x3c, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x42,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75,
+	0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x46, 0x75, 0x6c, 0x6c, 0x73, 0x22, 0x62, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x58, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x59, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50,
+	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0d, 0x70, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x8e,
+	0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x67, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x57,
+	0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73,
+	0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x76, 0x61, 0x70, 0x69, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x61, 0x70, 0x69, 0x64, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x75, 0x74, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x74, 0x65,
+	0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x75,
+	0x74, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x65, 0x0a, 0x12, 0x4d, 0x75, 0x74, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x0d, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x0d, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22,
+	0x5e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22,
+	0x70, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4f, 0x0a, 0x0d, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x0d, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x22, 0x65, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x4e, 0x6f, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48,
+	0x0a, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75,
+	0x72, 0x62, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x4e, 0x6f,
+	0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x22, 0x66, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44,
+	0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e, 0x44, 0x6f,
+	0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62,
+	0x22, 0x20, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62, 0x2e,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x48, 0x0a, 0x0c, 0x64, 0x6f, 0x4e,
+	0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x63, 0x77, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x50, 0x62,
+	0x2e, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x75, 0x72, 0x62, 0x42, 0x1c, 0x5a, 0x1a, 0x73, 0x6f, 0x6c, 0x2e, 0x67, 0x6f, 0x2f, 0x63, 0x77,
+	0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_grpc_cwm_rq_res_account_proto_rawDescOnce sync.Once
+	file_grpc_cwm_rq_res_account_proto_rawDescData = file_grpc_cwm_rq_res_account_proto_rawDesc
+)
+
+func file_grpc_cwm_rq_res_account_proto_rawDescGZIP() []byte {
+	file_grpc_cwm_rq_res_account_proto_rawDescOnce.Do(func() {
+		file_grpc_cwm_rq_res_account_proto_rawDescData = protoimpl.X.CompressGZIP(file_grpc_cwm_rq_res_account_proto_rawDescData)
+	})
+	return file_grpc_cwm_rq_res_account_proto_rawDescData
+}
+
+var file_grpc_cwm_rq_res_account_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_grpc_cwm_rq_res_account_proto_goTypes = []interface{}{
+	(*CreatAccountRequest)(nil),                      // 0: grpcCWMPb.CreatAccountRequest
+	(*CreatAccountResponse)(nil),                     // 1: grpcCWMPb.CreatAccountResponse
+	(*VerifyAuthencodeRequest)(nil),                  // 2: grpcCWMPb.VerifyAuthencodeRequest
+	(*VerifyAuthencodeResponse)(nil),                 // 3: grpcCWMPb.VerifyAuthencodeResponse
+	(*LoginRequest)(nil),                             // 4: grpcCWMPb.LoginRequest
+	(*LoginResponse)(nil),                            // 5: grpcCWMPb.LoginResponse
+	(*SyncContactRequest)(nil),                       // 6: grpcCWMPb.SyncContactRequest
+	(*SyncContactResponse)(nil),                      // 7: grpcCWMPb.SyncContactResponse
+	(*UpdateProfileRequest)(nil),                     // 8: grpcCWMPb.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),                    // 9: grpcCWMPb.UpdateProfileResponse
+	(*UploadAvatarRequest)(nil),                      // 10: grpcCWMPb.UploadAvatarRequest
+	(*UploadAvatarResponse)(nil),                     // 11: grpcCWMPb.UploadAvatarResponse
+	(*UpdateUsernameRequest)(nil),                    // 12: grpcCWMPb.UpdateUsernameRequest
+	(*UpdateUsernameResponse)(nil),                   // 13: grpcCWMPb.UpdateUsernameResponse
+	(*SearchByUsernameRequest)(nil),                  // 14: grpcCWMPb.SearchByUsernameRequest
+	(*SearchByUsernameResponse)(nil),                 // 15: grpcCWMPb.SearchByUsernameResponse
+	(*SearchByPhoneFullRequest)(nil),                 // 16: grpcCWMPb.SearchByPhoneFullRequest
+	(*SearchByPhoneFullResponse)(nil),                // 17: grpcCWMPb.SearchByPhoneFullResponse
+	(*FindByListPhoneFullRequest)(nil),               // 18: grpcCWMPb.FindByListPhoneFullRequest
+	(*FindByListPhoneFullResponse)(nil),              // 19: grpcCWMPb.FindByListPhoneFullResponse
+	(*UpdatePushTokenRequest)(nil),                   // 20: grpcCWMPb.UpdatePushTokenRequest
+	(*UpdatePushTokenResponse)(nil),                  // 21: grpcCWMPb.UpdatePushTokenResponse
+	(*UpdateWebPushSubscriptionRequest)(nil),         // 22: grpcCWMPb.UpdateWebPushSubscriptionRequest
+	(*UpdateWebPushSubscriptionResponse)(nil),        // 23: grpcCWMPb.UpdateWebPushSubscriptionResponse
+	(*GetWebPushConfigRequest)(nil),                  // 24: grpcCWMPb.GetWebPushConfigRequest
+	(*GetWebPushConfigResponse)(nil),                 // 25: grpcCWMPb.GetWebPushConfigResponse
+	(*MuteThreadRequest)(nil),                        // 26: grpcCWMPb.MuteThreadRequest
+	(*MuteThreadResponse)(nil),                       // 27: grpcCWMPb.MuteThreadResponse
+	(*SetThreadMentionsOnlyRequest)(nil),             // 28: grpcCWMPb.SetThreadMentionsOnlyRequest
+	(*SetThreadMentionsOnlyResponse)(nil),            // 29: grpcCWMPb.SetThreadMentionsOnlyResponse
+	(*UpdateDoNotDisturbRequest)(nil),                // 30: grpcCWMPb.UpdateDoNotDisturbRequest
+	(*UpdateDoNotDisturbResponse)(nil),               // 31: grpcCWMPb.UpdateDoNotDisturbResponse
+	(*GetNotificationSettingsRequest)(nil),           // 32: grpcCWMPb.GetNotificationSettingsRequest
+	(*GetNotificationSettingsResponse)(nil),          // 33: grpcCWMPb.GetNotificationSettingsResponse
+	(*DeviceInfo)(nil),                               // 34: grpcCWMPb.DeviceInfo
+	(*ContactInfo)(nil),                              // 35: grpcCWMPb.ContactInfo
+	(*SearchUserInfo)(nil),                           // 36: grpcCWMPb.SearchUserInfo
+	(*PushTokenInfo)(nil),                            // 37: grpcCWMPb.PushTokenInfo
+	(*WebPushSubscription)(nil),                      // 38: grpcCWMPb.WebPushSubscription
+	(*cwmSignalMsgPb.ThreadNotificationSetting)(nil), // 39: cwmSignalMsgPb.ThreadNotificationSetting
+	(*cwmSignalMsgPb.DoNotDisturbSchedule)(nil),      // 40: cwmSignalMsgPb.DoNotDisturbSchedule
+}
+var file_grpc_cwm_rq_res_account_proto_depIdxs = []int32{
+	34, // 0: grpcCWMPb.VerifyAuthencodeRequest.deviceInfo:type_name -> grpcCWMPb.DeviceInfo
+	34, // 1: grpcCWMPb.VerifyAuthencodeResponse.deviceInfo:type_name -> grpcCWMPb.DeviceInfo
+	35, // 2: grpcCWMPb.SyncContactRequest.contactInfo:type_name -> grpcCWMPb.ContactInfo
+	35, // 3: grpcCWMPb.SyncContactResponse.contactInfo:type_name -> grpcCWMPb.ContactInfo
+	36, // 4: grpcCWMPb.SearchByUsernameResponse.searchUserInfos:type_name -> grpcCWMPb.SearchUserInfo
+	36, // 5: grpcCWMPb.SearchByPhoneFullResponse.searchUserInfos:type_name -> grpcCWMPb.SearchUserInfo
+	36, // 6: grpcCWMPb.FindByListPhoneFullResponse.searchUserInfos:type_name -> grpcCWMPb.SearchUserInfo
+	37, // 7: grpcCWMPb.UpdatePushTokenRequest.pushTokenInfo:type_name -> grpcCWMPb.PushTokenInfo
+	37, // 8: grpcCWMPb.UpdatePushTokenResponse.pushTokenInfo:type_name -> grpcCWMPb.PushTokenInfo
+	38, // 9: grpcCWMPb.UpdateWebPushSubscriptionRequest.subscription:type_name -> grpcCWMPb.WebPushSubscription
+	38, // 10: grpcCWMPb.UpdateWebPushSubscriptionResponse.subscription:type_name -> grpcCWMPb.WebPushSubscription
+	39, // 11: grpcCWMPb.MuteThreadResponse.threadSetting:type_name -> cwmSignalMsgPb.ThreadNotificationSetting
+	39, // 12: grpcCWMPb.SetThreadMentionsOnlyResponse.threadSetting:type_name -> cwmSignalMsgPb.ThreadNotificationSetting
+	40, // 13: grpcCWMPb.UpdateDoNotDisturbRequest.doNotDisturb:type_name -> cwmSignalMsgPb.DoNotDisturbSchedule
+	40, // 14: grpcCWMPb.UpdateDoNotDisturbResponse.doNotDisturb:type_name -> cwmSignalMsgPb.DoNotDisturbSchedule
+	39, // 15: grpcCWMPb.GetNotificationSettingsResponse.threadSettings:type_name -> cwmSignalMsgPb.ThreadNotificationSetting
+	40, // 16: grpcCWMPb.GetNotificationSettingsResponse.doNotDisturb:type_name -> cwmSignalMsgPb.DoNotDisturbSchedule
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_grpc_cwm_rq_res_account_proto_init() }
+func file_grpc_cwm_rq_res_account_proto_init() {
+	if File_grpc_cwm_rq_res_account_proto != nil {
+		return
+	}
+	file_grpc_cwm_model_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_grpc_cwm_rq_res_account_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyAuthencodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifyAuthencodeResponse); i {
@@ -1425,7 +2253,7 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUsernameRequest); i {
+			switch v := v.(*UploadAvatarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1437,7 +2265,7 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUsernameResponse); i {
+			switch v := v.(*UploadAvatarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1449,7 +2277,7 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchByUsernameRequest); i {
+			switch v := v.(*UpdateUsernameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1461,7 +2289,7 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchByUsernameResponse); i {
+			switch v := v.(*UpdateUsernameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1473,7 +2301,7 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchByPhoneFullRequest); i {
+			switch v := v.(*SearchByUsernameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1485,7 +2313,7 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchByPhoneFullResponse); i {
+			switch v := v.(*SearchByUsernameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1497,7 +2325,7 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindByListPhoneFullRequest); i {
+			switch v := v.(*SearchByPhoneFullRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1509,7 +2337,7 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindByListPhoneFullResponse); i {
+			switch v := v.(*SearchByPhoneFullResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1521,7 +2349,7 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePushTokenRequest); i {
+			switch v := v.(*FindByListPhoneFullRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1533,6 +2361,30 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_account_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindByListPhoneFullResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePushTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdatePushTokenResponse); i {
 			case 0:
 				return &v.state
@@ -1544,14 +2396,163 @@ func file_grpc_cwm_rq_res_account_proto_init() {
 				return nil
 			}
 		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWebPushSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWebPushSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebPushConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebPushConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteThreadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteThreadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetThreadMentionsOnlyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetThreadMentionsOnlyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDoNotDisturbRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDoNotDisturbResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_account_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_grpc_cwm_rq_res_account_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*UploadAvatarRequest_Checksum)(nil),
+		(*UploadAvatarRequest_ChunkData)(nil),
 	}
+	file_grpc_cwm_rq_res_account_proto_msgTypes[22].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_cwm_rq_res_account_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -836,6 +836,306 @@ func (x *DeleteSoloThreadResponse) GetCount() int64 {
 	return 0
 }
 
+// -------------------GET UNREAD COUNT--------------------------------//
+type GetUnreadCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUnreadCountRequest) Reset() {
+	*x = GetUnreadCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{16}
+}
+
+type ThreadUnreadCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadId string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Count    int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ThreadUnreadCount) Reset() {
+	*x = ThreadUnreadCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadUnreadCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadUnreadCount) ProtoMessage() {}
+
+func (x *ThreadUnreadCount) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadUnreadCount.ProtoReflect.Descriptor instead.
+func (*ThreadUnreadCount) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ThreadUnreadCount) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *ThreadUnreadCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetUnreadCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total        int64                `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`              //badge number
+	ThreadCounts []*ThreadUnreadCount `protobuf:"bytes,2,rep,name=threadCounts,proto3" json:"threadCounts,omitempty"` //threads with unread msgs
+}
+
+func (x *GetUnreadCountResponse) Reset() {
+	*x = GetUnreadCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUnreadCountResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUnreadCountResponse) GetThreadCounts() []*ThreadUnreadCount {
+	if x != nil {
+		return x.ThreadCounts
+	}
+	return nil
+}
+
+// -------------------CHECK MEDIA EXISTS--------------------------------//
+type CheckMediaExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaMsgInfo *MediaMsgInfo `protobuf:"bytes,1,opt,name=mediaMsgInfo,proto3" json:"mediaMsgInfo,omitempty"`
+	FileSize     int64         `protobuf:"varint,2,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+}
+
+func (x *CheckMediaExistsRequest) Reset() {
+	*x = CheckMediaExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckMediaExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMediaExistsRequest) ProtoMessage() {}
+
+func (x *CheckMediaExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMediaExistsRequest.ProtoReflect.Descriptor instead.
+func (*CheckMediaExistsRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckMediaExistsRequest) GetMediaMsgInfo() *MediaMsgInfo {
+	if x != nil {
+		return x.MediaMsgInfo
+	}
+	return nil
+}
+
+func (x *CheckMediaExistsRequest) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+type CheckMediaExistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists           bool   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"` //false - the file must be uploaded
+	FileId           string `protobuf:"bytes,2,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	FileName         string `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	FileSize         int64  `protobuf:"varint,4,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	CheckSum         string `protobuf:"bytes,5,opt,name=checkSum,proto3" json:"checkSum,omitempty"` //md5 of the stored file
+	MsgId            string `protobuf:"bytes,6,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	OriginalChecksum string `protobuf:"bytes,7,opt,name=originalChecksum,proto3" json:"originalChecksum,omitempty"` //md5 declared by the client
+}
+
+func (x *CheckMediaExistsResponse) Reset() {
+	*x = CheckMediaExistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckMediaExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMediaExistsResponse) ProtoMessage() {}
+
+func (x *CheckMediaExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMediaExistsResponse.ProtoReflect.Descriptor instead.
+func (*CheckMediaExistsResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckMediaExistsResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *CheckMediaExistsResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *CheckMediaExistsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CheckMediaExistsResponse) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *CheckMediaExistsResponse) GetCheckSum() string {
+	if x != nil {
+		return x.CheckSum
+	}
+	return ""
+}
+
+func (x *CheckMediaExistsResponse) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *CheckMediaExistsResponse) GetOriginalChecksum() string {
+	if x != nil {
+		return x.OriginalChecksum
+	}
+	return ""
+}
+
 // -------------------UPLOAD MEDIA MSG--------------------------------//
 type UploadMediaMsgRequest struct {
 	state         protoimpl.MessageState
@@ -852,7 +1152,7 @@ type UploadMediaMsgRequest struct {
 func (x *UploadMediaMsgRequest) Reset() {
 	*x = UploadMediaMsgRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[16]
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -865,7 +1165,7 @@ func (x *UploadMediaMsgRequest) String() string {
 func (*UploadMediaMsgRequest) ProtoMessage() {}
 
 func (x *UploadMediaMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[16]
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1178,7 @@ func (x *UploadMediaMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadMediaMsgRequest.ProtoReflect.Descriptor instead.
 func (*UploadMediaMsgRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{16}
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{21}
 }
 
 func (m *UploadMediaMsgRequest) GetData() isUploadMediaMsgRequest_Data {
@@ -923,17 +1223,18 @@ type UploadMediaMsgResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileId   string `protobuf:"bytes,1,opt,name=fileId,proto3" json:"fileId,omitempty"`
-	FileName string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
-	FileSize int64  `protobuf:"varint,3,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
-	CheckSum string `protobuf:"bytes,4,opt,name=checkSum,proto3" json:"checkSum,omitempty"`
-	MsgId    string `protobuf:"bytes,5,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	FileId           string `protobuf:"bytes,1,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	FileName         string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	FileSize         int64  `protobuf:"varint,3,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	CheckSum         string `protobuf:"bytes,4,opt,name=checkSum,proto3" json:"checkSum,omitempty"` //md5 of the stored file, differs from the declared one when the metadata of an image are removed
+	MsgId            string `protobuf:"bytes,5,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	OriginalChecksum string `protobuf:"bytes,6,opt,name=originalChecksum,proto3" json:"originalChecksum,omitempty"` //md5 declared by the client
 }
 
 func (x *UploadMediaMsgResponse) Reset() {
 	*x = UploadMediaMsgResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[17]
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -946,7 +1247,7 @@ func (x *UploadMediaMsgResponse) String() string {
 func (*UploadMediaMsgResponse) ProtoMessage() {}
 
 func (x *UploadMediaMsgResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[17]
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1260,7 @@ func (x *UploadMediaMsgResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadMediaMsgResponse.ProtoReflect.Descriptor instead.
 func (*UploadMediaMsgResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{17}
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UploadMediaMsgResponse) GetFileId() string {
@@ -992,38 +1293,1005 @@ func (x *UploadMediaMsgResponse) GetCheckSum() string {
 
 func (x *UploadMediaMsgResponse) GetMsgId() string {
 	if x != nil {
-		return x.MsgId
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *UploadMediaMsgResponse) GetOriginalChecksum() string {
+	if x != nil {
+		return x.OriginalChecksum
+	}
+	return ""
+}
+
+// -------------------RESUMABLE UPLOAD MEDIA MSG--------------------------------//
+type BeginUploadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaMsgInfo *MediaMsgInfo `protobuf:"bytes,1,opt,name=mediaMsgInfo,proto3" json:"mediaMsgInfo,omitempty"`
+	FileSize     int64         `protobuf:"varint,2,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+}
+
+func (x *BeginUploadRequest) Reset() {
+	*x = BeginUploadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeginUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginUploadRequest) ProtoMessage() {}
+
+func (x *BeginUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginUploadRequest.ProtoReflect.Descriptor instead.
+func (*BeginUploadRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BeginUploadRequest) GetMediaMsgInfo() *MediaMsgInfo {
+	if x != nil {
+		return x.MediaMsgInfo
+	}
+	return nil
+}
+
+func (x *BeginUploadRequest) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+type BeginUploadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId  string `protobuf:"bytes,1,opt,name=uploadId,proto3" json:"uploadId,omitempty"`
+	ChunkSize int64  `protobuf:"varint,2,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"` //every chunk must have this size, except the last one
+	ExpiredAt int64  `protobuf:"varint,3,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"` //the upload is dropped if no chunk is received until expiredAt
+}
+
+func (x *BeginUploadResponse) Reset() {
+	*x = BeginUploadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeginUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginUploadResponse) ProtoMessage() {}
+
+func (x *BeginUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginUploadResponse.ProtoReflect.Descriptor instead.
+func (*BeginUploadResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BeginUploadResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *BeginUploadResponse) GetChunkSize() int64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *BeginUploadResponse) GetExpiredAt() int64 {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return 0
+}
+
+type UploadChunkInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId string `protobuf:"bytes,1,opt,name=uploadId,proto3" json:"uploadId,omitempty"`
+	Offset   int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` //must be the offset returned by QueryUploadOffset / the previous UploadChunk
+}
+
+func (x *UploadChunkInfo) Reset() {
+	*x = UploadChunkInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadChunkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunkInfo) ProtoMessage() {}
+
+func (x *UploadChunkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChunkInfo.ProtoReflect.Descriptor instead.
+func (*UploadChunkInfo) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UploadChunkInfo) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadChunkInfo) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type UploadChunkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//
+	//	*UploadChunkRequest_ChunkInfo
+	//	*UploadChunkRequest_ChunkData
+	Data isUploadChunkRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *UploadChunkRequest) Reset() {
+	*x = UploadChunkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunkRequest) ProtoMessage() {}
+
+func (x *UploadChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChunkRequest.ProtoReflect.Descriptor instead.
+func (*UploadChunkRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{26}
+}
+
+func (m *UploadChunkRequest) GetData() isUploadChunkRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadChunkRequest) GetChunkInfo() *UploadChunkInfo {
+	if x, ok := x.GetData().(*UploadChunkRequest_ChunkInfo); ok {
+		return x.ChunkInfo
+	}
+	return nil
+}
+
+func (x *UploadChunkRequest) GetChunkData() []byte {
+	if x, ok := x.GetData().(*UploadChunkRequest_ChunkData); ok {
+		return x.ChunkData
+	}
+	return nil
+}
+
+type isUploadChunkRequest_Data interface {
+	isUploadChunkRequest_Data()
+}
+
+type UploadChunkRequest_ChunkInfo struct {
+	ChunkInfo *UploadChunkInfo `protobuf:"bytes,1,opt,name=chunkInfo,proto3,oneof"`
+}
+
+type UploadChunkRequest_ChunkData struct {
+	ChunkData []byte `protobuf:"bytes,2,opt,name=chunk_data,json=chunkData,proto3,oneof"`
+}
+
+func (*UploadChunkRequest_ChunkInfo) isUploadChunkRequest_Data() {}
+
+func (*UploadChunkRequest_ChunkData) isUploadChunkRequest_Data() {}
+
+type UploadChunkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId  string `protobuf:"bytes,1,opt,name=uploadId,proto3" json:"uploadId,omitempty"`
+	Offset    int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` //offset of the next chunk
+	ExpiredAt int64  `protobuf:"varint,3,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
+}
+
+func (x *UploadChunkResponse) Reset() {
+	*x = UploadChunkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunkResponse) ProtoMessage() {}
+
+func (x *UploadChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChunkResponse.ProtoReflect.Descriptor instead.
+func (*UploadChunkResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UploadChunkResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadChunkResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadChunkResponse) GetExpiredAt() int64 {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return 0
+}
+
+type QueryUploadOffsetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId string `protobuf:"bytes,1,opt,name=uploadId,proto3" json:"uploadId,omitempty"`
+}
+
+func (x *QueryUploadOffsetRequest) Reset() {
+	*x = QueryUploadOffsetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryUploadOffsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUploadOffsetRequest) ProtoMessage() {}
+
+func (x *QueryUploadOffsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUploadOffsetRequest.ProtoReflect.Descriptor instead.
+func (*QueryUploadOffsetRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QueryUploadOffsetRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+type QueryUploadOffsetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId  string `protobuf:"bytes,1,opt,name=uploadId,proto3" json:"uploadId,omitempty"`
+	Offset    int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` //offset of the next chunk, resume the upload from here
+	FileSize  int64  `protobuf:"varint,3,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	ChunkSize int64  `protobuf:"varint,4,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+	ExpiredAt int64  `protobuf:"varint,5,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
+}
+
+func (x *QueryUploadOffsetResponse) Reset() {
+	*x = QueryUploadOffsetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryUploadOffsetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUploadOffsetResponse) ProtoMessage() {}
+
+func (x *QueryUploadOffsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUploadOffsetResponse.ProtoReflect.Descriptor instead.
+func (*QueryUploadOffsetResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QueryUploadOffsetResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *QueryUploadOffsetResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *QueryUploadOffsetResponse) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *QueryUploadOffsetResponse) GetChunkSize() int64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *QueryUploadOffsetResponse) GetExpiredAt() int64 {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return 0
+}
+
+type CompleteUploadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId string `protobuf:"bytes,1,opt,name=uploadId,proto3" json:"uploadId,omitempty"`
+}
+
+func (x *CompleteUploadRequest) Reset() {
+	*x = CompleteUploadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteUploadRequest) ProtoMessage() {}
+
+func (x *CompleteUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteUploadRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CompleteUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+type CompleteUploadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId           string `protobuf:"bytes,1,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	FileName         string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	FileSize         int64  `protobuf:"varint,3,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	CheckSum         string `protobuf:"bytes,4,opt,name=checkSum,proto3" json:"checkSum,omitempty"` //md5 of the stored file, differs from the declared one when the metadata of an image are removed
+	MsgId            string `protobuf:"bytes,5,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	OriginalChecksum string `protobuf:"bytes,6,opt,name=originalChecksum,proto3" json:"originalChecksum,omitempty"` //md5 declared by the client
+}
+
+func (x *CompleteUploadResponse) Reset() {
+	*x = CompleteUploadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteUploadResponse) ProtoMessage() {}
+
+func (x *CompleteUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteUploadResponse.ProtoReflect.Descriptor instead.
+func (*CompleteUploadResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CompleteUploadResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *CompleteUploadResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CompleteUploadResponse) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *CompleteUploadResponse) GetCheckSum() string {
+	if x != nil {
+		return x.CheckSum
+	}
+	return ""
+}
+
+func (x *CompleteUploadResponse) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *CompleteUploadResponse) GetOriginalChecksum() string {
+	if x != nil {
+		return x.OriginalChecksum
+	}
+	return ""
+}
+
+// -------------------DOWNLOAD MEDIA FILE--------------------------------//
+type DownloadMediaMsgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId  string        `protobuf:"bytes,1,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	MsgId   string        `protobuf:"bytes,2,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	Variant MEDIA_VARIANT `protobuf:"varint,3,opt,name=variant,proto3,enum=grpcCWMPb.MEDIA_VARIANT" json:"variant,omitempty"` //PLACEHOLDER / PREVIEW are only generated for the images, a few seconds after the upload
+	Offset  int64         `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`                                //resume an interrupted download from the received size
+}
+
+func (x *DownloadMediaMsgRequest) Reset() {
+	*x = DownloadMediaMsgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadMediaMsgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadMediaMsgRequest) ProtoMessage() {}
+
+func (x *DownloadMediaMsgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadMediaMsgRequest.ProtoReflect.Descriptor instead.
+func (*DownloadMediaMsgRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DownloadMediaMsgRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *DownloadMediaMsgRequest) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *DownloadMediaMsgRequest) GetVariant() MEDIA_VARIANT {
+	if x != nil {
+		return x.Variant
+	}
+	return MEDIA_VARIANT_ORIGINAL
+}
+
+func (x *DownloadMediaMsgRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type DownloadMediaMsgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkData []byte `protobuf:"bytes,1,opt,name=chunk_data,json=chunkData,proto3" json:"chunk_data,omitempty"`
+}
+
+func (x *DownloadMediaMsgResponse) Reset() {
+	*x = DownloadMediaMsgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadMediaMsgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadMediaMsgResponse) ProtoMessage() {}
+
+func (x *DownloadMediaMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadMediaMsgResponse.ProtoReflect.Descriptor instead.
+func (*DownloadMediaMsgResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DownloadMediaMsgResponse) GetChunkData() []byte {
+	if x != nil {
+		return x.ChunkData
+	}
+	return nil
+}
+
+// -------------------MEDIA URL--------------------------------//
+type GetMediaURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId  string        `protobuf:"bytes,1,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	MsgId   string        `protobuf:"bytes,2,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	Variant MEDIA_VARIANT `protobuf:"varint,3,opt,name=variant,proto3,enum=grpcCWMPb.MEDIA_VARIANT" json:"variant,omitempty"`
+}
+
+func (x *GetMediaURLRequest) Reset() {
+	*x = GetMediaURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMediaURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMediaURLRequest) ProtoMessage() {}
+
+func (x *GetMediaURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMediaURLRequest.ProtoReflect.Descriptor instead.
+func (*GetMediaURLRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMediaURLRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *GetMediaURLRequest) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *GetMediaURLRequest) GetVariant() MEDIA_VARIANT {
+	if x != nil {
+		return x.Variant
+	}
+	return MEDIA_VARIANT_ORIGINAL
+}
+
+type GetMediaURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url       string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`              //supports Range & If-None-Match
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"` //unix millis
+}
+
+func (x *GetMediaURLResponse) Reset() {
+	*x = GetMediaURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMediaURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMediaURLResponse) ProtoMessage() {}
+
+func (x *GetMediaURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMediaURLResponse.ProtoReflect.Descriptor instead.
+func (*GetMediaURLResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMediaURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetMediaURLResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// -------------------STORAGE USAGE--------------------------------//
+type GetStorageUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStorageUsageRequest) Reset() {
+	*x = GetStorageUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStorageUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageRequest) ProtoMessage() {}
+
+func (x *GetStorageUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{36}
+}
+
+type GetStorageUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoredBytes      int64 `protobuf:"varint,1,opt,name=storedBytes,proto3" json:"storedBytes,omitempty"` //size of the media uploaded by the user, a file shared by several msgs is counted for each msg
+	FileCount        int64 `protobuf:"varint,2,opt,name=fileCount,proto3" json:"fileCount,omitempty"`
+	QuotaBytes       int64 `protobuf:"varint,3,opt,name=quotaBytes,proto3" json:"quotaBytes,omitempty"`
+	DailyUploads     int64 `protobuf:"varint,4,opt,name=dailyUploads,proto3" json:"dailyUploads,omitempty"`
+	DailyUploadLimit int64 `protobuf:"varint,5,opt,name=dailyUploadLimit,proto3" json:"dailyUploadLimit,omitempty"`
+	DailyBytes       int64 `protobuf:"varint,6,opt,name=dailyBytes,proto3" json:"dailyBytes,omitempty"`
+	DailyBytesLimit  int64 `protobuf:"varint,7,opt,name=dailyBytesLimit,proto3" json:"dailyBytesLimit,omitempty"`
+	DailyResetAt     int64 `protobuf:"varint,8,opt,name=dailyResetAt,proto3" json:"dailyResetAt,omitempty"` //unix millis, the daily counters are reset at 00:00 UTC
+}
+
+func (x *GetStorageUsageResponse) Reset() {
+	*x = GetStorageUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStorageUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageResponse) ProtoMessage() {}
+
+func (x *GetStorageUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetStorageUsageResponse) GetStoredBytes() int64 {
+	if x != nil {
+		return x.StoredBytes
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetFileCount() int64 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetDailyUploads() int64 {
+	if x != nil {
+		return x.DailyUploads
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetDailyUploadLimit() int64 {
+	if x != nil {
+		return x.DailyUploadLimit
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetDailyBytes() int64 {
+	if x != nil {
+		return x.DailyBytes
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetDailyBytesLimit() int64 {
+	if x != nil {
+		return x.DailyBytesLimit
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetDailyResetAt() int64 {
+	if x != nil {
+		return x.DailyResetAt
 	}
-	return ""
+	return 0
 }
 
-// -------------------DOWNLOAD MEDIA FILE--------------------------------//
-type DownloadMediaMsgRequest struct {
+// -------------------THREAD MEDIA--------------------------------//
+type ListThreadMediaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileId string `protobuf:"bytes,1,opt,name=fileId,proto3" json:"fileId,omitempty"`
-	MsgId  string `protobuf:"bytes,2,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	ThreadId  string            `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	MediaType THREAD_MEDIA_TYPE `protobuf:"varint,2,opt,name=mediaType,proto3,enum=grpcCWMPb.THREAD_MEDIA_TYPE" json:"mediaType,omitempty"`
+	Cursor    string            `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`      //nextCursor of the previous page, empty for the first page
+	Limit     *int64            `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"` //default 30, max 100
 }
 
-func (x *DownloadMediaMsgRequest) Reset() {
-	*x = DownloadMediaMsgRequest{}
+func (x *ListThreadMediaRequest) Reset() {
+	*x = ListThreadMediaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[18]
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DownloadMediaMsgRequest) String() string {
+func (x *ListThreadMediaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadMediaMsgRequest) ProtoMessage() {}
+func (*ListThreadMediaRequest) ProtoMessage() {}
 
-func (x *DownloadMediaMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[18]
+func (x *ListThreadMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1034,50 +2302,65 @@ func (x *DownloadMediaMsgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadMediaMsgRequest.ProtoReflect.Descriptor instead.
-func (*DownloadMediaMsgRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListThreadMediaRequest.ProtoReflect.Descriptor instead.
+func (*ListThreadMediaRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *DownloadMediaMsgRequest) GetFileId() string {
+func (x *ListThreadMediaRequest) GetThreadId() string {
 	if x != nil {
-		return x.FileId
+		return x.ThreadId
 	}
 	return ""
 }
 
-func (x *DownloadMediaMsgRequest) GetMsgId() string {
+func (x *ListThreadMediaRequest) GetMediaType() THREAD_MEDIA_TYPE {
 	if x != nil {
-		return x.MsgId
+		return x.MediaType
+	}
+	return THREAD_MEDIA_TYPE_MEDIA
+}
+
+func (x *ListThreadMediaRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
 	return ""
 }
 
-type DownloadMediaMsgResponse struct {
+func (x *ListThreadMediaRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListThreadMediaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChunkData []byte `protobuf:"bytes,1,opt,name=chunk_data,json=chunkData,proto3" json:"chunk_data,omitempty"`
+	Medias     []*ThreadMediaInfo `protobuf:"bytes,1,rep,name=medias,proto3" json:"medias,omitempty"`
+	NextCursor string             `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"` //empty on the last page
 }
 
-func (x *DownloadMediaMsgResponse) Reset() {
-	*x = DownloadMediaMsgResponse{}
+func (x *ListThreadMediaResponse) Reset() {
+	*x = ListThreadMediaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[19]
+		mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DownloadMediaMsgResponse) String() string {
+func (x *ListThreadMediaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadMediaMsgResponse) ProtoMessage() {}
+func (*ListThreadMediaResponse) ProtoMessage() {}
 
-func (x *DownloadMediaMsgResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[19]
+func (x *ListThreadMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_msg_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,18 +2371,25 @@ func (x *DownloadMediaMsgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadMediaMsgResponse.ProtoReflect.Descriptor instead.
-func (*DownloadMediaMsgResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListThreadMediaResponse.ProtoReflect.Descriptor instead.
+func (*ListThreadMediaResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_msg_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DownloadMediaMsgResponse) GetChunkData() []byte {
+func (x *ListThreadMediaResponse) GetMedias() []*ThreadMediaInfo {
 	if x != nil {
-		return x.ChunkData
+		return x.Medias
 	}
 	return nil
 }
 
+func (x *ListThreadMediaResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 var File_grpc_cwm_rq_res_msg_proto protoreflect.FileDescriptor
 
 var file_grpc_cwm_rq_res_msg_proto_rawDesc = []byte{
@@ -1188,35 +2478,191 @@ var file_grpc_cwm_rq_res_msg_proto_rawDesc = []byte{
 	0x72, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6c, 0x6f,
 	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
 	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7f, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65,
-	0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a,
+	0x11, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x70, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57,
+	0x4d, 0x50, 0x62, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x18, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x73, 0x67, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x7f, 0x0a,
+	0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d,
+	0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc6,
+	0x01, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x53, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x53, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x6d, 0x0a, 0x12, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a,
 	0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e,
-	0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0c,
-	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0a,
-	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42, 0x06, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05,
-	0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67,
-	0x49, 0x64, 0x22, 0x47, 0x0a, 0x17, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65,
-	0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x18, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42, 0x1c, 0x5a, 0x1a, 0x73, 0x6f, 0x6c, 0x2e, 0x67, 0x6f,
-	0x2f, 0x63, 0x77, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x43,
-	0x57, 0x4d, 0x50, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6d, 0x0a, 0x13, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x79, 0x0a, 0x12,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50,
+	0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f,
+	0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42,
+	0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x67, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x36, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x33, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x75, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x75, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
+	0x73, 0x67, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x22, 0x93, 0x01, 0x0a, 0x17, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x56, 0x41,
+	0x52, 0x49, 0x41, 0x4e, 0x54, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x39, 0x0a, 0x18, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x76, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d,
+	0x50, 0x62, 0x2e, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54,
+	0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb7, 0x02, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x41, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x54, 0x48, 0x52, 0x45, 0x41,
+	0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x6d, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62, 0x2e, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x42, 0x1c, 0x5a, 0x1a, 0x73, 0x6f, 0x6c, 0x2e, 0x67, 0x6f, 0x2f, 0x63, 0x77,
+	0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1231,7 +2677,7 @@ func file_grpc_cwm_rq_res_msg_proto_rawDescGZIP() []byte {
 	return file_grpc_cwm_rq_res_msg_proto_rawDescData
 }
 
-var file_grpc_cwm_rq_res_msg_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_grpc_cwm_rq_res_msg_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_grpc_cwm_rq_res_msg_proto_goTypes = []interface{}{
 	(*InitialSyncMsgRequest)(nil),         // 0: grpcCWMPb.InitialSyncMsgRequest
 	(*InitialSyncMsgResponse)(nil),        // 1: grpcCWMPb.InitialSyncMsgResponse
@@ -1249,28 +2695,59 @@ var file_grpc_cwm_rq_res_msg_proto_goTypes = []interface{}{
 	(*ClearAllMsgOfThreadResponse)(nil),   // 13: grpcCWMPb.ClearAllMsgOfThreadResponse
 	(*DeleteSoloThreadRequest)(nil),       // 14: grpcCWMPb.DeleteSoloThreadRequest
 	(*DeleteSoloThreadResponse)(nil),      // 15: grpcCWMPb.DeleteSoloThreadResponse
-	(*UploadMediaMsgRequest)(nil),         // 16: grpcCWMPb.UploadMediaMsgRequest
-	(*UploadMediaMsgResponse)(nil),        // 17: grpcCWMPb.UploadMediaMsgResponse
-	(*DownloadMediaMsgRequest)(nil),       // 18: grpcCWMPb.DownloadMediaMsgRequest
-	(*DownloadMediaMsgResponse)(nil),      // 19: grpcCWMPb.DownloadMediaMsgResponse
-	(*GroupThreadInfo)(nil),               // 20: grpcCWMPb.GroupThreadInfo
-	(*cwmSIPPb.CWMRequest)(nil),           // 21: cwmSIPPb.CWMRequest
-	(*cwmSIPPb.CWMResponse)(nil),          // 22: cwmSIPPb.CWMResponse
-	(*MediaMsgInfo)(nil),                  // 23: grpcCWMPb.MediaMsgInfo
+	(*GetUnreadCountRequest)(nil),         // 16: grpcCWMPb.GetUnreadCountRequest
+	(*ThreadUnreadCount)(nil),             // 17: grpcCWMPb.ThreadUnreadCount
+	(*GetUnreadCountResponse)(nil),        // 18: grpcCWMPb.GetUnreadCountResponse
+	(*CheckMediaExistsRequest)(nil),       // 19: grpcCWMPb.CheckMediaExistsRequest
+	(*CheckMediaExistsResponse)(nil),      // 20: grpcCWMPb.CheckMediaExistsResponse
+	(*UploadMediaMsgRequest)(nil),         // 21: grpcCWMPb.UploadMediaMsgRequest
+	(*UploadMediaMsgResponse)(nil),        // 22: grpcCWMPb.UploadMediaMsgResponse
+	(*BeginUploadRequest)(nil),            // 23: grpcCWMPb.BeginUploadRequest
+	(*BeginUploadResponse)(nil),           // 24: grpcCWMPb.BeginUploadResponse
+	(*UploadChunkInfo)(nil),               // 25: grpcCWMPb.UploadChunkInfo
+	(*UploadChunkRequest)(nil),            // 26: grpcCWMPb.UploadChunkRequest
+	(*UploadChunkResponse)(nil),           // 27: grpcCWMPb.UploadChunkResponse
+	(*QueryUploadOffsetRequest)(nil),      // 28: grpcCWMPb.QueryUploadOffsetRequest
+	(*QueryUploadOffsetResponse)(nil),     // 29: grpcCWMPb.QueryUploadOffsetResponse
+	(*CompleteUploadRequest)(nil),         // 30: grpcCWMPb.CompleteUploadRequest
+	(*CompleteUploadResponse)(nil),        // 31: grpcCWMPb.CompleteUploadResponse
+	(*DownloadMediaMsgRequest)(nil),       // 32: grpcCWMPb.DownloadMediaMsgRequest
+	(*DownloadMediaMsgResponse)(nil),      // 33: grpcCWMPb.DownloadMediaMsgResponse
+	(*GetMediaURLRequest)(nil),            // 34: grpcCWMPb.GetMediaURLRequest
+	(*GetMediaURLResponse)(nil),           // 35: grpcCWMPb.GetMediaURLResponse
+	(*GetStorageUsageRequest)(nil),        // 36: grpcCWMPb.GetStorageUsageRequest
+	(*GetStorageUsageResponse)(nil),       // 37: grpcCWMPb.GetStorageUsageResponse
+	(*ListThreadMediaRequest)(nil),        // 38: grpcCWMPb.ListThreadMediaRequest
+	(*ListThreadMediaResponse)(nil),       // 39: grpcCWMPb.ListThreadMediaResponse
+	(*GroupThreadInfo)(nil),               // 40: grpcCWMPb.GroupThreadInfo
+	(*cwmSIPPb.CWMRequest)(nil),           // 41: cwmSIPPb.CWMRequest
+	(*cwmSIPPb.CWMResponse)(nil),          // 42: cwmSIPPb.CWMResponse
+	(*MediaMsgInfo)(nil),                  // 43: grpcCWMPb.MediaMsgInfo
+	(MEDIA_VARIANT)(0),                    // 44: grpcCWMPb.MEDIA_VARIANT
+	(THREAD_MEDIA_TYPE)(0),                // 45: grpcCWMPb.THREAD_MEDIA_TYPE
+	(*ThreadMediaInfo)(nil),               // 46: grpcCWMPb.ThreadMediaInfo
 }
 var file_grpc_cwm_rq_res_msg_proto_depIdxs = []int32{
-	20, // 0: grpcCWMPb.InitialSyncMsgResponse.groupThreadInfo:type_name -> grpcCWMPb.GroupThreadInfo
-	21, // 1: grpcCWMPb.InitialSyncMsgResponse.msg:type_name -> cwmSIPPb.CWMRequest
-	21, // 2: grpcCWMPb.SendMsgRequest.msg:type_name -> cwmSIPPb.CWMRequest
-	22, // 3: grpcCWMPb.SendMsgResponse.msgResponse:type_name -> cwmSIPPb.CWMResponse
-	21, // 4: grpcCWMPb.FetchAllUnreceivedMsgResponse.msg:type_name -> cwmSIPPb.CWMRequest
-	21, // 5: grpcCWMPb.FetchOldMsgOfThreadResponse.msg:type_name -> cwmSIPPb.CWMRequest
-	23, // 6: grpcCWMPb.UploadMediaMsgRequest.mediaMsgInfo:type_name -> grpcCWMPb.MediaMsgInfo
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	40, // 0: grpcCWMPb.InitialSyncMsgResponse.groupThreadInfo:type_name -> grpcCWMPb.GroupThreadInfo
+	41, // 1: grpcCWMPb.InitialSyncMsgResponse.msg:type_name -> cwmSIPPb.CWMRequest
+	41, // 2: grpcCWMPb.SendMsgRequest.msg:type_name -> cwmSIPPb.CWMRequest
+	42, // 3: grpcCWMPb.SendMsgResponse.msgResponse:type_name -> cwmSIPPb.CWMResponse
+	41, // 4: grpcCWMPb.FetchAllUnreceivedMsgResponse.msg:type_name -> cwmSIPPb.CWMRequest
+	41, // 5: grpcCWMPb.FetchOldMsgOfThreadResponse.msg:type_name -> cwmSIPPb.CWMRequest
+	17, // 6: grpcCWMPb.GetUnreadCountResponse.threadCounts:type_name -> grpcCWMPb.ThreadUnreadCount
+	43, // 7: grpcCWMPb.CheckMediaExistsRequest.mediaMsgInfo:type_name -> grpcCWMPb.MediaMsgInfo
+	43, // 8: grpcCWMPb.UploadMediaMsgRequest.mediaMsgInfo:type_name -> grpcCWMPb.MediaMsgInfo
+	43, // 9: grpcCWMPb.BeginUploadRequest.mediaMsgInfo:type_name -> grpcCWMPb.MediaMsgInfo
+	25, // 10: grpcCWMPb.UploadChunkRequest.chunkInfo:type_name -> grpcCWMPb.UploadChunkInfo
+	44, // 11: grpcCWMPb.DownloadMediaMsgRequest.variant:type_name -> grpcCWMPb.MEDIA_VARIANT
+	44, // 12: grpcCWMPb.GetMediaURLRequest.variant:type_name -> grpcCWMPb.MEDIA_VARIANT
+	45, // 13: grpcCWMPb.ListThreadMediaRequest.mediaType:type_name -> grpcCWMPb.THREAD_MEDIA_TYPE
+	46, // 14: grpcCWMPb.ListThreadMediaResponse.medias:type_name -> grpcCWMPb.ThreadMediaInfo
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_grpc_cwm_rq_res_msg_proto_init() }
@@ -1473,7 +2950,7 @@ func file_grpc_cwm_rq_res_msg_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_msg_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadMediaMsgRequest); i {
+			switch v := v.(*GetUnreadCountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1485,7 +2962,7 @@ func file_grpc_cwm_rq_res_msg_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_msg_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadMediaMsgResponse); i {
+			switch v := v.(*ThreadUnreadCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1497,7 +2974,7 @@ func file_grpc_cwm_rq_res_msg_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_msg_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadMediaMsgRequest); i {
+			switch v := v.(*GetUnreadCountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1509,6 +2986,174 @@ func file_grpc_cwm_rq_res_msg_proto_init() {
 			}
 		}
 		file_grpc_cwm_rq_res_msg_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckMediaExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckMediaExistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadMediaMsgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadMediaMsgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginUploadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadChunkInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadChunkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadChunkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUploadOffsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUploadOffsetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteUploadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadMediaMsgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadMediaMsgResponse); i {
 			case 0:
 				return &v.state
@@ -1520,20 +3165,97 @@ func file_grpc_cwm_rq_res_msg_proto_init() {
 				return nil
 			}
 		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMediaURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMediaURLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStorageUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStorageUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListThreadMediaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_msg_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListThreadMediaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_grpc_cwm_rq_res_msg_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	file_grpc_cwm_rq_res_msg_proto_msgTypes[8].OneofWrappers = []interface{}{}
-	file_grpc_cwm_rq_res_msg_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_grpc_cwm_rq_res_msg_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*UploadMediaMsgRequest_MediaMsgInfo)(nil),
 		(*UploadMediaMsgRequest_ChunkData)(nil),
 	}
+	file_grpc_cwm_rq_res_msg_proto_msgTypes[26].OneofWrappers = []interface{}{
+		(*UploadChunkRequest_ChunkInfo)(nil),
+		(*UploadChunkRequest_ChunkData)(nil),
+	}
+	file_grpc_cwm_rq_res_msg_proto_msgTypes[38].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_cwm_rq_res_msg_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
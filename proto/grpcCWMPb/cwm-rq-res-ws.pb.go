@@ -0,0 +1,248 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: grpc/cwm-rq-res-ws.proto
+
+package grpcCWMPb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// -------------------WS RPC--------------------------------//
+type WSRPCRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Payload   []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *WSRPCRequest) Reset() {
+	*x = WSRPCRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_ws_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WSRPCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WSRPCRequest) ProtoMessage() {}
+
+func (x *WSRPCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_ws_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WSRPCRequest.ProtoReflect.Descriptor instead.
+func (*WSRPCRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_ws_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WSRPCRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *WSRPCRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type WSRPCResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string   `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Code      int32    `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Error     *string  `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Payloads  [][]byte `protobuf:"bytes,4,rep,name=payloads,proto3" json:"payloads,omitempty"` //response msgs of the corresponding grpc API, server streaming APIs return many
+}
+
+func (x *WSRPCResponse) Reset() {
+	*x = WSRPCResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_cwm_rq_res_ws_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WSRPCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WSRPCResponse) ProtoMessage() {}
+
+func (x *WSRPCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_cwm_rq_res_ws_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WSRPCResponse.ProtoReflect.Descriptor instead.
+func (*WSRPCResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_cwm_rq_res_ws_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WSRPCResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *WSRPCResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *WSRPCResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *WSRPCResponse) GetPayloads() [][]byte {
+	if x != nil {
+		return x.Payloads
+	}
+	return nil
+}
+
+var File_grpc_cwm_rq_res_ws_proto protoreflect.FileDescriptor
+
+var file_grpc_cwm_rq_res_ws_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x77, 0x6d, 0x2d, 0x72, 0x71, 0x2d, 0x72, 0x65,
+	0x73, 0x2d, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x67, 0x72, 0x70, 0x63,
+	0x43, 0x57, 0x4d, 0x50, 0x62, 0x22, 0x46, 0x0a, 0x0c, 0x57, 0x53, 0x52, 0x50, 0x43, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x82, 0x01,
+	0x0a, 0x0d, 0x57, 0x53, 0x52, 0x50, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x1c, 0x5a, 0x1a, 0x73, 0x6f, 0x6c, 0x2e, 0x67, 0x6f, 0x2f, 0x63, 0x77, 0x6d,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x43, 0x57, 0x4d, 0x50, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_grpc_cwm_rq_res_ws_proto_rawDescOnce sync.Once
+	file_grpc_cwm_rq_res_ws_proto_rawDescData = file_grpc_cwm_rq_res_ws_proto_rawDesc
+)
+
+func file_grpc_cwm_rq_res_ws_proto_rawDescGZIP() []byte {
+	file_grpc_cwm_rq_res_ws_proto_rawDescOnce.Do(func() {
+		file_grpc_cwm_rq_res_ws_proto_rawDescData = protoimpl.X.CompressGZIP(file_grpc_cwm_rq_res_ws_proto_rawDescData)
+	})
+	return file_grpc_cwm_rq_res_ws_proto_rawDescData
+}
+
+var file_grpc_cwm_rq_res_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_grpc_cwm_rq_res_ws_proto_goTypes = []interface{}{
+	(*WSRPCRequest)(nil),  // 0: grpcCWMPb.WSRPCRequest
+	(*WSRPCResponse)(nil), // 1: grpcCWMPb.WSRPCResponse
+}
+var file_grpc_cwm_rq_res_ws_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_grpc_cwm_rq_res_ws_proto_init() }
+func file_grpc_cwm_rq_res_ws_proto_init() {
+	if File_grpc_cwm_rq_res_ws_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_grpc_cwm_rq_res_ws_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WSRPCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_cwm_rq_res_ws_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WSRPCResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_grpc_cwm_rq_res_ws_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_grpc_cwm_rq_res_ws_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_grpc_cwm_rq_res_ws_proto_goTypes,
+		DependencyIndexes: file_grpc_cwm_rq_res_ws_proto_depIdxs,
+		MessageInfos:      file_grpc_cwm_rq_res_ws_proto_msgTypes,
+	}.Build()
+	File_grpc_cwm_rq_res_ws_proto = out.File
+	file_grpc_cwm_rq_res_ws_proto_rawDesc = nil
+	file_grpc_cwm_rq_res_ws_proto_goTypes = nil
+	file_grpc_cwm_rq_res_ws_proto_depIdxs = nil
+}
@@ -28,11 +28,18 @@ type CWMServiceClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	SyncContact(ctx context.Context, opts ...grpc.CallOption) (CWMService_SyncContactClient, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	UploadAvatar(ctx context.Context, opts ...grpc.CallOption) (CWMService_UploadAvatarClient, error)
 	UpdateUsername(ctx context.Context, in *UpdateUsernameRequest, opts ...grpc.CallOption) (*UpdateUsernameResponse, error)
 	SearchByUsername(ctx context.Context, in *SearchByUsernameRequest, opts ...grpc.CallOption) (*SearchByUsernameResponse, error)
 	SearchByPhoneFull(ctx context.Context, in *SearchByPhoneFullRequest, opts ...grpc.CallOption) (*SearchByPhoneFullResponse, error)
 	FindByListPhoneFull(ctx context.Context, in *FindByListPhoneFullRequest, opts ...grpc.CallOption) (*FindByListPhoneFullResponse, error)
 	UpdatePushToken(ctx context.Context, in *UpdatePushTokenRequest, opts ...grpc.CallOption) (*UpdatePushTokenResponse, error)
+	UpdateWebPushSubscription(ctx context.Context, in *UpdateWebPushSubscriptionRequest, opts ...grpc.CallOption) (*UpdateWebPushSubscriptionResponse, error)
+	GetWebPushConfig(ctx context.Context, in *GetWebPushConfigRequest, opts ...grpc.CallOption) (*GetWebPushConfigResponse, error)
+	MuteThread(ctx context.Context, in *MuteThreadRequest, opts ...grpc.CallOption) (*MuteThreadResponse, error)
+	SetThreadMentionsOnly(ctx context.Context, in *SetThreadMentionsOnlyRequest, opts ...grpc.CallOption) (*SetThreadMentionsOnlyResponse, error)
+	UpdateDoNotDisturb(ctx context.Context, in *UpdateDoNotDisturbRequest, opts ...grpc.CallOption) (*UpdateDoNotDisturbResponse, error)
+	GetNotificationSettings(ctx context.Context, in *GetNotificationSettingsRequest, opts ...grpc.CallOption) (*GetNotificationSettingsResponse, error)
 	// Thread APIs
 	CreateGroupThread(ctx context.Context, in *CreateGroupThreadRequest, opts ...grpc.CallOption) (*CreateGroupThreadResponse, error)
 	CheckGroupThreadInfo(ctx context.Context, in *CheckGroupThreadInfoRequest, opts ...grpc.CallOption) (*CheckGroupThreadInfoResponse, error)
@@ -52,8 +59,17 @@ type CWMServiceClient interface {
 	DeleteMsgsOfThread(ctx context.Context, in *DeleteMsgsOfThreadRequest, opts ...grpc.CallOption) (*DeleteMsgsOfThreadResponse, error)
 	ClearAllMsgOfThread(ctx context.Context, in *ClearAllMsgOfThreadRequest, opts ...grpc.CallOption) (*ClearAllMsgOfThreadResponse, error)
 	DeleteSoloThread(ctx context.Context, in *DeleteSoloThreadRequest, opts ...grpc.CallOption) (*DeleteSoloThreadResponse, error)
+	GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error)
+	CheckMediaExists(ctx context.Context, in *CheckMediaExistsRequest, opts ...grpc.CallOption) (*CheckMediaExistsResponse, error)
 	UploadMediaMsg(ctx context.Context, opts ...grpc.CallOption) (CWMService_UploadMediaMsgClient, error)
+	BeginUpload(ctx context.Context, in *BeginUploadRequest, opts ...grpc.CallOption) (*BeginUploadResponse, error)
+	UploadChunk(ctx context.Context, opts ...grpc.CallOption) (CWMService_UploadChunkClient, error)
+	QueryUploadOffset(ctx context.Context, in *QueryUploadOffsetRequest, opts ...grpc.CallOption) (*QueryUploadOffsetResponse, error)
+	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadResponse, error)
 	DownloadMediaMsg(ctx context.Context, in *DownloadMediaMsgRequest, opts ...grpc.CallOption) (CWMService_DownloadMediaMsgClient, error)
+	GetMediaURL(ctx context.Context, in *GetMediaURLRequest, opts ...grpc.CallOption) (*GetMediaURLResponse, error)
+	GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error)
+	ListThreadMedia(ctx context.Context, in *ListThreadMediaRequest, opts ...grpc.CallOption) (*ListThreadMediaResponse, error)
 }
 
 type cWMServiceClient struct {
@@ -131,6 +147,40 @@ func (c *cWMServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileR
 	return out, nil
 }
 
+func (c *cWMServiceClient) UploadAvatar(ctx context.Context, opts ...grpc.CallOption) (CWMService_UploadAvatarClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[1], "/grpcCWMPb.CWMService/UploadAvatar", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cWMServiceUploadAvatarClient{stream}
+	return x, nil
+}
+
+type CWMService_UploadAvatarClient interface {
+	Send(*UploadAvatarRequest) error
+	CloseAndRecv() (*UploadAvatarResponse, error)
+	grpc.ClientStream
+}
+
+type cWMServiceUploadAvatarClient struct {
+	grpc.ClientStream
+}
+
+func (x *cWMServiceUploadAvatarClient) Send(m *UploadAvatarRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cWMServiceUploadAvatarClient) CloseAndRecv() (*UploadAvatarResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadAvatarResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *cWMServiceClient) UpdateUsername(ctx context.Context, in *UpdateUsernameRequest, opts ...grpc.CallOption) (*UpdateUsernameResponse, error) {
 	out := new(UpdateUsernameResponse)
 	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/UpdateUsername", in, out, opts...)
@@ -176,6 +226,60 @@ func (c *cWMServiceClient) UpdatePushToken(ctx context.Context, in *UpdatePushTo
 	return out, nil
 }
 
+func (c *cWMServiceClient) UpdateWebPushSubscription(ctx context.Context, in *UpdateWebPushSubscriptionRequest, opts ...grpc.CallOption) (*UpdateWebPushSubscriptionResponse, error) {
+	out := new(UpdateWebPushSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/UpdateWebPushSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) GetWebPushConfig(ctx context.Context, in *GetWebPushConfigRequest, opts ...grpc.CallOption) (*GetWebPushConfigResponse, error) {
+	out := new(GetWebPushConfigResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/GetWebPushConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) MuteThread(ctx context.Context, in *MuteThreadRequest, opts ...grpc.CallOption) (*MuteThreadResponse, error) {
+	out := new(MuteThreadResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/MuteThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) SetThreadMentionsOnly(ctx context.Context, in *SetThreadMentionsOnlyRequest, opts ...grpc.CallOption) (*SetThreadMentionsOnlyResponse, error) {
+	out := new(SetThreadMentionsOnlyResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/SetThreadMentionsOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) UpdateDoNotDisturb(ctx context.Context, in *UpdateDoNotDisturbRequest, opts ...grpc.CallOption) (*UpdateDoNotDisturbResponse, error) {
+	out := new(UpdateDoNotDisturbResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/UpdateDoNotDisturb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) GetNotificationSettings(ctx context.Context, in *GetNotificationSettingsRequest, opts ...grpc.CallOption) (*GetNotificationSettingsResponse, error) {
+	out := new(GetNotificationSettingsResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/GetNotificationSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cWMServiceClient) CreateGroupThread(ctx context.Context, in *CreateGroupThreadRequest, opts ...grpc.CallOption) (*CreateGroupThreadResponse, error) {
 	out := new(CreateGroupThreadResponse)
 	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/CreateGroupThread", in, out, opts...)
@@ -258,7 +362,7 @@ func (c *cWMServiceClient) DeleteAndLeaveGroupThread(ctx context.Context, in *De
 }
 
 func (c *cWMServiceClient) InitialSyncMsg(ctx context.Context, in *InitialSyncMsgRequest, opts ...grpc.CallOption) (CWMService_InitialSyncMsgClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[1], "/grpcCWMPb.CWMService/InitialSyncMsg", opts...)
+	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[2], "/grpcCWMPb.CWMService/InitialSyncMsg", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -290,7 +394,7 @@ func (x *cWMServiceInitialSyncMsgClient) Recv() (*InitialSyncMsgResponse, error)
 }
 
 func (c *cWMServiceClient) FetchAllUnreceivedMsg(ctx context.Context, in *FetchAllUnreceivedMsgRequest, opts ...grpc.CallOption) (CWMService_FetchAllUnreceivedMsgClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[2], "/grpcCWMPb.CWMService/FetchAllUnreceivedMsg", opts...)
+	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[3], "/grpcCWMPb.CWMService/FetchAllUnreceivedMsg", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +426,7 @@ func (x *cWMServiceFetchAllUnreceivedMsgClient) Recv() (*FetchAllUnreceivedMsgRe
 }
 
 func (c *cWMServiceClient) FetchOldMsgOfThread(ctx context.Context, in *FetchOldMsgOfThreadRequest, opts ...grpc.CallOption) (CWMService_FetchOldMsgOfThreadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[3], "/grpcCWMPb.CWMService/FetchOldMsgOfThread", opts...)
+	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[4], "/grpcCWMPb.CWMService/FetchOldMsgOfThread", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -398,8 +502,26 @@ func (c *cWMServiceClient) DeleteSoloThread(ctx context.Context, in *DeleteSoloT
 	return out, nil
 }
 
+func (c *cWMServiceClient) GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error) {
+	out := new(GetUnreadCountResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/GetUnreadCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) CheckMediaExists(ctx context.Context, in *CheckMediaExistsRequest, opts ...grpc.CallOption) (*CheckMediaExistsResponse, error) {
+	out := new(CheckMediaExistsResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/CheckMediaExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cWMServiceClient) UploadMediaMsg(ctx context.Context, opts ...grpc.CallOption) (CWMService_UploadMediaMsgClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[4], "/grpcCWMPb.CWMService/UploadMediaMsg", opts...)
+	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[5], "/grpcCWMPb.CWMService/UploadMediaMsg", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -432,8 +554,69 @@ func (x *cWMServiceUploadMediaMsgClient) CloseAndRecv() (*UploadMediaMsgResponse
 	return m, nil
 }
 
+func (c *cWMServiceClient) BeginUpload(ctx context.Context, in *BeginUploadRequest, opts ...grpc.CallOption) (*BeginUploadResponse, error) {
+	out := new(BeginUploadResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/BeginUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) UploadChunk(ctx context.Context, opts ...grpc.CallOption) (CWMService_UploadChunkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[6], "/grpcCWMPb.CWMService/UploadChunk", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cWMServiceUploadChunkClient{stream}
+	return x, nil
+}
+
+type CWMService_UploadChunkClient interface {
+	Send(*UploadChunkRequest) error
+	CloseAndRecv() (*UploadChunkResponse, error)
+	grpc.ClientStream
+}
+
+type cWMServiceUploadChunkClient struct {
+	grpc.ClientStream
+}
+
+func (x *cWMServiceUploadChunkClient) Send(m *UploadChunkRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cWMServiceUploadChunkClient) CloseAndRecv() (*UploadChunkResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadChunkResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cWMServiceClient) QueryUploadOffset(ctx context.Context, in *QueryUploadOffsetRequest, opts ...grpc.CallOption) (*QueryUploadOffsetResponse, error) {
+	out := new(QueryUploadOffsetResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/QueryUploadOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadResponse, error) {
+	out := new(CompleteUploadResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/CompleteUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cWMServiceClient) DownloadMediaMsg(ctx context.Context, in *DownloadMediaMsgRequest, opts ...grpc.CallOption) (CWMService_DownloadMediaMsgClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[5], "/grpcCWMPb.CWMService/DownloadMediaMsg", opts...)
+	stream, err := c.cc.NewStream(ctx, &CWMService_ServiceDesc.Streams[7], "/grpcCWMPb.CWMService/DownloadMediaMsg", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -464,6 +647,33 @@ func (x *cWMServiceDownloadMediaMsgClient) Recv() (*DownloadMediaMsgResponse, er
 	return m, nil
 }
 
+func (c *cWMServiceClient) GetMediaURL(ctx context.Context, in *GetMediaURLRequest, opts ...grpc.CallOption) (*GetMediaURLResponse, error) {
+	out := new(GetMediaURLResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/GetMediaURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error) {
+	out := new(GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cWMServiceClient) ListThreadMedia(ctx context.Context, in *ListThreadMediaRequest, opts ...grpc.CallOption) (*ListThreadMediaResponse, error) {
+	out := new(ListThreadMediaResponse)
+	err := c.cc.Invoke(ctx, "/grpcCWMPb.CWMService/ListThreadMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CWMServiceServer is the server API for CWMService service.
 // All implementations must embed UnimplementedCWMServiceServer
 // for forward compatibility
@@ -474,11 +684,18 @@ type CWMServiceServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	SyncContact(CWMService_SyncContactServer) error
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	UploadAvatar(CWMService_UploadAvatarServer) error
 	UpdateUsername(context.Context, *UpdateUsernameRequest) (*UpdateUsernameResponse, error)
 	SearchByUsername(context.Context, *SearchByUsernameRequest) (*SearchByUsernameResponse, error)
 	SearchByPhoneFull(context.Context, *SearchByPhoneFullRequest) (*SearchByPhoneFullResponse, error)
 	FindByListPhoneFull(context.Context, *FindByListPhoneFullRequest) (*FindByListPhoneFullResponse, error)
 	UpdatePushToken(context.Context, *UpdatePushTokenRequest) (*UpdatePushTokenResponse, error)
+	UpdateWebPushSubscription(context.Context, *UpdateWebPushSubscriptionRequest) (*UpdateWebPushSubscriptionResponse, error)
+	GetWebPushConfig(context.Context, *GetWebPushConfigRequest) (*GetWebPushConfigResponse, error)
+	MuteThread(context.Context, *MuteThreadRequest) (*MuteThreadResponse, error)
+	SetThreadMentionsOnly(context.Context, *SetThreadMentionsOnlyRequest) (*SetThreadMentionsOnlyResponse, error)
+	UpdateDoNotDisturb(context.Context, *UpdateDoNotDisturbRequest) (*UpdateDoNotDisturbResponse, error)
+	GetNotificationSettings(context.Context, *GetNotificationSettingsRequest) (*GetNotificationSettingsResponse, error)
 	// Thread APIs
 	CreateGroupThread(context.Context, *CreateGroupThreadRequest) (*CreateGroupThreadResponse, error)
 	CheckGroupThreadInfo(context.Context, *CheckGroupThreadInfoRequest) (*CheckGroupThreadInfoResponse, error)
@@ -498,8 +715,17 @@ type CWMServiceServer interface {
 	DeleteMsgsOfThread(context.Context, *DeleteMsgsOfThreadRequest) (*DeleteMsgsOfThreadResponse, error)
 	ClearAllMsgOfThread(context.Context, *ClearAllMsgOfThreadRequest) (*ClearAllMsgOfThreadResponse, error)
 	DeleteSoloThread(context.Context, *DeleteSoloThreadRequest) (*DeleteSoloThreadResponse, error)
+	GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error)
+	CheckMediaExists(context.Context, *CheckMediaExistsRequest) (*CheckMediaExistsResponse, error)
 	UploadMediaMsg(CWMService_UploadMediaMsgServer) error
+	BeginUpload(context.Context, *BeginUploadRequest) (*BeginUploadResponse, error)
+	UploadChunk(CWMService_UploadChunkServer) error
+	QueryUploadOffset(context.Context, *QueryUploadOffsetRequest) (*QueryUploadOffsetResponse, error)
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadResponse, error)
 	DownloadMediaMsg(*DownloadMediaMsgRequest, CWMService_DownloadMediaMsgServer) error
+	GetMediaURL(context.Context, *GetMediaURLRequest) (*GetMediaURLResponse, error)
+	GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error)
+	ListThreadMedia(context.Context, *ListThreadMediaRequest) (*ListThreadMediaResponse, error)
 	mustEmbedUnimplementedCWMServiceServer()
 }
 
@@ -522,6 +748,9 @@ func (UnimplementedCWMServiceServer) SyncContact(CWMService_SyncContactServer) e
 func (UnimplementedCWMServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedCWMServiceServer) UploadAvatar(CWMService_UploadAvatarServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAvatar not implemented")
+}
 func (UnimplementedCWMServiceServer) UpdateUsername(context.Context, *UpdateUsernameRequest) (*UpdateUsernameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUsername not implemented")
 }
@@ -537,6 +766,24 @@ func (UnimplementedCWMServiceServer) FindByListPhoneFull(context.Context, *FindB
 func (UnimplementedCWMServiceServer) UpdatePushToken(context.Context, *UpdatePushTokenRequest) (*UpdatePushTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePushToken not implemented")
 }
+func (UnimplementedCWMServiceServer) UpdateWebPushSubscription(context.Context, *UpdateWebPushSubscriptionRequest) (*UpdateWebPushSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebPushSubscription not implemented")
+}
+func (UnimplementedCWMServiceServer) GetWebPushConfig(context.Context, *GetWebPushConfigRequest) (*GetWebPushConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebPushConfig not implemented")
+}
+func (UnimplementedCWMServiceServer) MuteThread(context.Context, *MuteThreadRequest) (*MuteThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteThread not implemented")
+}
+func (UnimplementedCWMServiceServer) SetThreadMentionsOnly(context.Context, *SetThreadMentionsOnlyRequest) (*SetThreadMentionsOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetThreadMentionsOnly not implemented")
+}
+func (UnimplementedCWMServiceServer) UpdateDoNotDisturb(context.Context, *UpdateDoNotDisturbRequest) (*UpdateDoNotDisturbResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDoNotDisturb not implemented")
+}
+func (UnimplementedCWMServiceServer) GetNotificationSettings(context.Context, *GetNotificationSettingsRequest) (*GetNotificationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSettings not implemented")
+}
 func (UnimplementedCWMServiceServer) CreateGroupThread(context.Context, *CreateGroupThreadRequest) (*CreateGroupThreadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupThread not implemented")
 }
@@ -588,12 +835,39 @@ func (UnimplementedCWMServiceServer) ClearAllMsgOfThread(context.Context, *Clear
 func (UnimplementedCWMServiceServer) DeleteSoloThread(context.Context, *DeleteSoloThreadRequest) (*DeleteSoloThreadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSoloThread not implemented")
 }
+func (UnimplementedCWMServiceServer) GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
+func (UnimplementedCWMServiceServer) CheckMediaExists(context.Context, *CheckMediaExistsRequest) (*CheckMediaExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMediaExists not implemented")
+}
 func (UnimplementedCWMServiceServer) UploadMediaMsg(CWMService_UploadMediaMsgServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadMediaMsg not implemented")
 }
+func (UnimplementedCWMServiceServer) BeginUpload(context.Context, *BeginUploadRequest) (*BeginUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginUpload not implemented")
+}
+func (UnimplementedCWMServiceServer) UploadChunk(CWMService_UploadChunkServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadChunk not implemented")
+}
+func (UnimplementedCWMServiceServer) QueryUploadOffset(context.Context, *QueryUploadOffsetRequest) (*QueryUploadOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUploadOffset not implemented")
+}
+func (UnimplementedCWMServiceServer) CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteUpload not implemented")
+}
 func (UnimplementedCWMServiceServer) DownloadMediaMsg(*DownloadMediaMsgRequest, CWMService_DownloadMediaMsgServer) error {
 	return status.Errorf(codes.Unimplemented, "method DownloadMediaMsg not implemented")
 }
+func (UnimplementedCWMServiceServer) GetMediaURL(context.Context, *GetMediaURLRequest) (*GetMediaURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMediaURL not implemented")
+}
+func (UnimplementedCWMServiceServer) GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
+func (UnimplementedCWMServiceServer) ListThreadMedia(context.Context, *ListThreadMediaRequest) (*ListThreadMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListThreadMedia not implemented")
+}
 func (UnimplementedCWMServiceServer) mustEmbedUnimplementedCWMServiceServer() {}
 
 // UnsafeCWMServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -705,6 +979,32 @@ func _CWMService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CWMService_UploadAvatar_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CWMServiceServer).UploadAvatar(&cWMServiceUploadAvatarServer{stream})
+}
+
+type CWMService_UploadAvatarServer interface {
+	SendAndClose(*UploadAvatarResponse) error
+	Recv() (*UploadAvatarRequest, error)
+	grpc.ServerStream
+}
+
+type cWMServiceUploadAvatarServer struct {
+	grpc.ServerStream
+}
+
+func (x *cWMServiceUploadAvatarServer) SendAndClose(m *UploadAvatarResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cWMServiceUploadAvatarServer) Recv() (*UploadAvatarRequest, error) {
+	m := new(UploadAvatarRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _CWMService_UpdateUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUsernameRequest)
 	if err := dec(in); err != nil {
@@ -795,6 +1095,114 @@ func _CWMService_UpdatePushToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CWMService_UpdateWebPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebPushSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).UpdateWebPushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/UpdateWebPushSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).UpdateWebPushSubscription(ctx, req.(*UpdateWebPushSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_GetWebPushConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebPushConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).GetWebPushConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/GetWebPushConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).GetWebPushConfig(ctx, req.(*GetWebPushConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_MuteThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).MuteThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/MuteThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).MuteThread(ctx, req.(*MuteThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_SetThreadMentionsOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetThreadMentionsOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).SetThreadMentionsOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/SetThreadMentionsOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).SetThreadMentionsOnly(ctx, req.(*SetThreadMentionsOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_UpdateDoNotDisturb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDoNotDisturbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).UpdateDoNotDisturb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/UpdateDoNotDisturb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).UpdateDoNotDisturb(ctx, req.(*UpdateDoNotDisturbRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_GetNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).GetNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/GetNotificationSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).GetNotificationSettings(ctx, req.(*GetNotificationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CWMService_CreateGroupThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateGroupThreadRequest)
 	if err := dec(in); err != nil {
@@ -1110,6 +1518,42 @@ func _CWMService_DeleteSoloThread_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CWMService_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/GetUnreadCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).GetUnreadCount(ctx, req.(*GetUnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_CheckMediaExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMediaExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).CheckMediaExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/CheckMediaExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).CheckMediaExists(ctx, req.(*CheckMediaExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CWMService_UploadMediaMsg_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(CWMServiceServer).UploadMediaMsg(&cWMServiceUploadMediaMsgServer{stream})
 }
@@ -1136,6 +1580,86 @@ func (x *cWMServiceUploadMediaMsgServer) Recv() (*UploadMediaMsgRequest, error)
 	return m, nil
 }
 
+func _CWMService_BeginUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).BeginUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/BeginUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).BeginUpload(ctx, req.(*BeginUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_UploadChunk_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CWMServiceServer).UploadChunk(&cWMServiceUploadChunkServer{stream})
+}
+
+type CWMService_UploadChunkServer interface {
+	SendAndClose(*UploadChunkResponse) error
+	Recv() (*UploadChunkRequest, error)
+	grpc.ServerStream
+}
+
+type cWMServiceUploadChunkServer struct {
+	grpc.ServerStream
+}
+
+func (x *cWMServiceUploadChunkServer) SendAndClose(m *UploadChunkResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cWMServiceUploadChunkServer) Recv() (*UploadChunkRequest, error) {
+	m := new(UploadChunkRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CWMService_QueryUploadOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUploadOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).QueryUploadOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/QueryUploadOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).QueryUploadOffset(ctx, req.(*QueryUploadOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_CompleteUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).CompleteUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/CompleteUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).CompleteUpload(ctx, req.(*CompleteUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CWMService_DownloadMediaMsg_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(DownloadMediaMsgRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1157,6 +1681,60 @@ func (x *cWMServiceDownloadMediaMsgServer) Send(m *DownloadMediaMsgResponse) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CWMService_GetMediaURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMediaURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).GetMediaURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/GetMediaURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).GetMediaURL(ctx, req.(*GetMediaURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/GetStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).GetStorageUsage(ctx, req.(*GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CWMService_ListThreadMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListThreadMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CWMServiceServer).ListThreadMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcCWMPb.CWMService/ListThreadMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CWMServiceServer).ListThreadMedia(ctx, req.(*ListThreadMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CWMService_ServiceDesc is the grpc.ServiceDesc for CWMService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1200,6 +1778,30 @@ var CWMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePushToken",
 			Handler:    _CWMService_UpdatePushToken_Handler,
 		},
+		{
+			MethodName: "UpdateWebPushSubscription",
+			Handler:    _CWMService_UpdateWebPushSubscription_Handler,
+		},
+		{
+			MethodName: "GetWebPushConfig",
+			Handler:    _CWMService_GetWebPushConfig_Handler,
+		},
+		{
+			MethodName: "MuteThread",
+			Handler:    _CWMService_MuteThread_Handler,
+		},
+		{
+			MethodName: "SetThreadMentionsOnly",
+			Handler:    _CWMService_SetThreadMentionsOnly_Handler,
+		},
+		{
+			MethodName: "UpdateDoNotDisturb",
+			Handler:    _CWMService_UpdateDoNotDisturb_Handler,
+		},
+		{
+			MethodName: "GetNotificationSettings",
+			Handler:    _CWMService_GetNotificationSettings_Handler,
+		},
 		{
 			MethodName: "CreateGroupThread",
 			Handler:    _CWMService_CreateGroupThread_Handler,
@@ -1256,6 +1858,38 @@ var CWMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSoloThread",
 			Handler:    _CWMService_DeleteSoloThread_Handler,
 		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _CWMService_GetUnreadCount_Handler,
+		},
+		{
+			MethodName: "CheckMediaExists",
+			Handler:    _CWMService_CheckMediaExists_Handler,
+		},
+		{
+			MethodName: "BeginUpload",
+			Handler:    _CWMService_BeginUpload_Handler,
+		},
+		{
+			MethodName: "QueryUploadOffset",
+			Handler:    _CWMService_QueryUploadOffset_Handler,
+		},
+		{
+			MethodName: "CompleteUpload",
+			Handler:    _CWMService_CompleteUpload_Handler,
+		},
+		{
+			MethodName: "GetMediaURL",
+			Handler:    _CWMService_GetMediaURL_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _CWMService_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "ListThreadMedia",
+			Handler:    _CWMService_ListThreadMedia_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1264,6 +1898,11 @@ var CWMService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UploadAvatar",
+			Handler:       _CWMService_UploadAvatar_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "InitialSyncMsg",
 			Handler:       _CWMService_InitialSyncMsg_Handler,
@@ -1284,6 +1923,11 @@ var CWMService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CWMService_UploadMediaMsg_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UploadChunk",
+			Handler:       _CWMService_UploadChunk_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "DownloadMediaMsg",
 			Handler:       _CWMService_DownloadMediaMsg_Handler,
@@ -2,7 +2,9 @@ package pubsub
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/go-redis/redis/v8"
 	"log"
 	"sol.go/cwm/dao"
 )
@@ -13,16 +15,23 @@ const (
 
 var Events = []string{EVENT_CWMMSG}
 
+var subscriber *redis.PubSub
+
 func StartSubscribe() {
 	cache := dao.GetCache()
-	subscriber := cache.RedisClient.Subscribe(context.Background(), Events...)
+	subscriber = cache.RedisClient.Subscribe(context.Background(), Events...)
 	userPubSubServer := UserPubSubHandler{}
 
-	go func() {
+	go func(subscriber *redis.PubSub) {
 		for {
 			msg, err := subscriber.ReceiveMessage(context.Background())
 			if err != nil {
+				if errors.Is(err, redis.ErrClosed) {
+					log.Println("redis Subscribe closed")
+					return
+				}
 				log.Println("redis Subscribe error", err)
+				continue
 			}
 
 			switch msg.Channel {
@@ -37,5 +46,12 @@ func StartSubscribe() {
 			}
 
 		}
-	}()
+	}(subscriber)
+}
+
+func StopSubscribe(ctx context.Context) error {
+	if subscriber == nil {
+		return nil
+	}
+	return subscriber.Close()
 }
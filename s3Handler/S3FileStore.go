@@ -2,12 +2,13 @@ package s3Handler
 
 import (
 	"bytes"
+	"context"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
-	"github.com/gabriel-vasile/mimetype"
+	"io"
 	"log"
 	"os"
 	"sync"
@@ -22,6 +23,11 @@ type S3FileStore struct {
 	mutex           sync.RWMutex
 }
 
+const (
+	uploadPartSize    = s3manager.MinUploadPartSize //5 MB
+	uploadConcurrency = 2
+)
+
 var singletonS3FileStore *S3FileStore
 var onceS3FileStore sync.Once
 
@@ -62,20 +68,33 @@ func ConnectAws() (string, string, string, string, *session.Session) {
 	return accessKeyID, secretAccessKey, region, bucket, session
 }
 
-func (s3FileStore *S3FileStore) UploadFile(fileName string, fileData bytes.Buffer, mimetype *mimetype.MIME) (*s3manager.UploadOutput, error) {
+// UploadFile streams body to S3 as a multipart upload, the uploader buffers at most
+// uploadPartSize * uploadConcurrency bytes whatever the size of the file
+func (s3FileStore *S3FileStore) UploadFile(ctx context.Context, fileName string, body io.Reader, contentType string) (*s3manager.UploadOutput, error) {
 	log.Println("Upload File to S3...")
 	//s3FileStore.mutex.Lock()
 	//defer s3FileStore.mutex.Unlock()
 
-	uploader := s3manager.NewUploader(s3FileStore.Session)
+	uploader := s3manager.NewUploader(s3FileStore.Session, func(uploader *s3manager.Uploader) {
+		uploader.PartSize = uploadPartSize
+		uploader.Concurrency = uploadConcurrency
+	})
 	//upload to the s3 bucket
-	return uploader.Upload(&s3manager.UploadInput{
+	return uploader.UploadWithContext(ctx, &s3manager.UploadInput{
 		Bucket: aws.String(s3FileStore.Bucket),
 		//ACL:    aws.String("public-read"),
 		Key:         aws.String(fileName),
-		Body:        bytes.NewReader(fileData.Bytes()),
-		ContentType: aws.String(mimetype.String()),
+		Body:        body,
+		ContentType: aws.String(contentType),
+	})
+}
+
+func (s3FileStore *S3FileStore) DeleteObject(ctx context.Context, fileName string) error {
+	_, err := s3.New(s3FileStore.Session).DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s3FileStore.Bucket),
+		Key:    aws.String(fileName),
 	})
+	return err
 }
 
 func (s3FileStore *S3FileStore) GetObject(fileName string) (*s3.GetObjectOutput, error) {
@@ -88,3 +107,62 @@ func (s3FileStore *S3FileStore) GetObject(fileName string) (*s3.GetObjectOutput,
 
 	return downloader.S3.GetObject(input)
 }
+
+func (s3FileStore *S3FileStore) CreateMultipartUpload(ctx context.Context, fileName string, contentType string) (string, error) {
+	output, err := s3.New(s3FileStore.Session).CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(s3FileStore.Bucket),
+		Key:         aws.String(fileName),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.UploadId), nil
+}
+
+// UploadPart uploads a part of a multipart upload, returns the ETag of the part
+func (s3FileStore *S3FileStore) UploadPart(ctx context.Context, fileName string, uploadId string, partNumber int64, data []byte) (string, error) {
+	output, err := s3.New(s3FileStore.Session).UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:        aws.String(s3FileStore.Bucket),
+		Key:           aws.String(fileName),
+		UploadId:      aws.String(uploadId),
+		PartNumber:    aws.Int64(partNumber),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.ETag), nil
+}
+
+// CompleteMultipartUpload assembles the parts, partETags are the ETags of the parts by part number
+func (s3FileStore *S3FileStore) CompleteMultipartUpload(ctx context.Context, fileName string, uploadId string, partETags map[int64]string) (*s3.CompleteMultipartUploadOutput, error) {
+	parts := []*s3.CompletedPart{}
+	for partNumber := int64(1); partNumber <= int64(len(partETags)); partNumber++ {
+		parts = append(parts, &s3.CompletedPart{
+			PartNumber: aws.Int64(partNumber),
+			ETag:       aws.String(partETags[partNumber]),
+		})
+	}
+
+	return s3.New(s3FileStore.Session).CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   aws.String(s3FileStore.Bucket),
+		Key:      aws.String(fileName),
+		UploadId: aws.String(uploadId),
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: parts,
+		},
+	})
+}
+
+func (s3FileStore *S3FileStore) AbortMultipartUpload(ctx context.Context, fileName string, uploadId string) error {
+	_, err := s3.New(s3FileStore.Session).AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(s3FileStore.Bucket),
+		Key:      aws.String(fileName),
+		UploadId: aws.String(uploadId),
+	})
+	return err
+}
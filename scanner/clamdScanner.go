@@ -0,0 +1,181 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	clamdChunkSize = 64 * 1024
+)
+
+// ClamdScanner streams the files to a clamd daemon with the INSTREAM command of the clamd protocol,
+// one connection per scan. The size of a stream is limited by StreamMaxLength of clamd.conf
+type ClamdScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamdScanner - address is tcp://host:port, host:port or unix:///path/clamd.sock
+func NewClamdScanner(address string, timeout time.Duration) (*ClamdScanner, error) {
+	network, addr := "tcp", address
+	if strings.Contains(address, "://") {
+		clamdURL, err := url.Parse(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid clamd address %s: %w", address, err)
+		}
+
+		switch clamdURL.Scheme {
+		case "tcp":
+			addr = clamdURL.Host
+		case "unix":
+			network, addr = "unix", clamdURL.Path
+		default:
+			return nil, fmt.Errorf("invalid clamd address %s: unsupported scheme", address)
+		}
+	}
+
+	if len(addr) == 0 {
+		return nil, fmt.Errorf("invalid clamd address %s", address)
+	}
+
+	return &ClamdScanner{
+		network: network,
+		address: addr,
+		timeout: timeout,
+	}, nil
+}
+
+func (s *ClamdScanner) Name() string {
+	return SCANNER_CLAMD
+}
+
+// Ping checks the daemon answers
+func (s *ClamdScanner) Ping(ctx context.Context) error {
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("zPING\x00"))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ScannerUnavailableErr, err)
+	}
+
+	reply, err := readReply(conn)
+	if err != nil {
+		return err
+	}
+	if reply != "PONG" {
+		return fmt.Errorf("%w: unexpected reply %s", ScannerUnavailableErr, reply)
+	}
+	return nil
+}
+
+// Scan sends the file in chunks prefixed by their size, a chunk of size 0 ends the stream
+func (s *ClamdScanner) Scan(ctx context.Context, body io.Reader) (*ScanResult, error) {
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	err = writeStream(conn, body)
+	if err != nil {
+		//clamd replies then closes the connection when the stream exceeds its limits
+		reply, replyErr := readReply(conn)
+		if replyErr != nil {
+			return nil, err
+		}
+		return parseScanReply(reply)
+	}
+
+	reply, err := readReply(conn)
+	if err != nil {
+		return nil, err
+	}
+	return parseScanReply(reply)
+}
+
+func (s *ClamdScanner) dial(ctx context.Context) (net.Conn, error) {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ScannerUnavailableErr, err)
+	}
+
+	deadline := time.Now().Add(s.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	conn.SetDeadline(deadline)
+	return conn, nil
+}
+
+func writeStream(conn net.Conn, body io.Reader) error {
+	_, err := conn.Write([]byte("zINSTREAM\x00"))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ScannerUnavailableErr, err)
+	}
+
+	chunk := make([]byte, 4+clamdChunkSize)
+	for {
+		n, readErr := io.ReadFull(body, chunk[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(chunk, uint32(n))
+			_, err = conn.Write(chunk[:4+n])
+			if err != nil {
+				return fmt.Errorf("%w: %v", ScannerUnavailableErr, err)
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return fmt.Errorf("cannot read file: %w", readErr)
+		}
+	}
+
+	_, err = conn.Write([]byte{0, 0, 0, 0})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ScannerUnavailableErr, err)
+	}
+	return nil
+}
+
+// readReply reads a reply of the z commands, terminated by \0
+func readReply(conn net.Conn) (string, error) {
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && len(reply) == 0 {
+		return "", fmt.Errorf("%w: %v", ScannerUnavailableErr, err)
+	}
+	return strings.TrimSpace(strings.TrimSuffix(reply, "\x00")), nil
+}
+
+// parseScanReply - "stream: OK", "stream: <signature> FOUND" or "<message> ERROR"
+func parseScanReply(reply string) (*ScanResult, error) {
+	result := strings.TrimPrefix(reply, "stream: ")
+
+	switch {
+	case result == "OK":
+		return &ScanResult{}, nil
+	case strings.HasSuffix(result, " FOUND"):
+		return &ScanResult{
+			Infected:  true,
+			Signature: strings.TrimSuffix(result, " FOUND"),
+		}, nil
+	case strings.Contains(result, "size limit exceeded"):
+		return nil, fmt.Errorf("%w: %s", ScanLimitErr, result)
+	default:
+		return nil, fmt.Errorf("clamd error: %s", result)
+	}
+}
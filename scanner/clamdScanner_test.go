@@ -0,0 +1,222 @@
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeClamd answers the INSTREAM and PING commands on a unix socket, reply returns the answer to a received stream
+type fakeClamd struct {
+	listener net.Listener
+	maxSize  int
+	reply    func(data []byte) string
+
+	streams chan []byte
+	chunks  chan []int
+}
+
+func newFakeClamd(t *testing.T, reply func(data []byte) string) (*fakeClamd, *ClamdScanner) {
+	socket := filepath.Join(t.TempDir(), "clamd.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	clamd := &fakeClamd{
+		listener: listener,
+		reply:    reply,
+		streams:  make(chan []byte, 1),
+		chunks:   make(chan []int, 1),
+	}
+	go clamd.serve()
+
+	clamdScanner, err := NewClamdScanner("unix://"+socket, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clamd, clamdScanner
+}
+
+func (clamd *fakeClamd) serve() {
+	for {
+		conn, err := clamd.listener.Accept()
+		if err != nil {
+			return
+		}
+		clamd.handle(conn)
+	}
+}
+
+func (clamd *fakeClamd) handle(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	command, err := reader.ReadString(0)
+	if err != nil {
+		return
+	}
+
+	switch command {
+	case "zPING\x00":
+		conn.Write([]byte("PONG\x00"))
+	case "zINSTREAM\x00":
+		data := bytes.Buffer{}
+		chunks := []int{}
+		for {
+			size := make([]byte, 4)
+			_, err = io.ReadFull(reader, size)
+			if err != nil {
+				return
+			}
+			n := int(binary.BigEndian.Uint32(size))
+			if n == 0 {
+				break
+			}
+
+			chunk := make([]byte, n)
+			_, err = io.ReadFull(reader, chunk)
+			if err != nil {
+				return
+			}
+			chunks = append(chunks, n)
+			data.Write(chunk)
+
+			//clamd replies then closes the connection as soon as the stream exceeds StreamMaxLength
+			if clamd.maxSize > 0 && data.Len() > clamd.maxSize {
+				conn.Write([]byte("INSTREAM size limit exceeded. ERROR\x00"))
+				return
+			}
+		}
+
+		clamd.streams <- data.Bytes()
+		clamd.chunks <- chunks
+		conn.Write([]byte(clamd.reply(data.Bytes()) + "\x00"))
+	}
+}
+
+func TestClamdScannerScan(t *testing.T) {
+	tests := []struct {
+		name      string
+		reply     string
+		want      *ScanResult
+		wantError bool
+	}{
+		{"ok", "stream: OK", &ScanResult{}, false},
+		{"found", "stream: Eicar-Test-Signature FOUND", &ScanResult{Infected: true, Signature: "Eicar-Test-Signature"}, false},
+		{"error", "stream: Can't allocate memory ERROR", nil, true},
+	}
+
+	for _, test := range tests {
+		clamd, clamdScanner := newFakeClamd(t, func(data []byte) string { return test.reply })
+
+		result, err := clamdScanner.Scan(context.Background(), strings.NewReader("file content"))
+		if test.wantError {
+			if err == nil || errors.Is(err, ScanLimitErr) || errors.Is(err, ScannerUnavailableErr) {
+				t.Errorf("%v: error = %v", test.name, err)
+			}
+		} else if err != nil || *result != *test.want {
+			t.Errorf("%v: result = %+v, %v, want %+v", test.name, result, err, test.want)
+		}
+
+		if data := <-clamd.streams; string(data) != "file content" {
+			t.Errorf("%v: clamd received %q", test.name, data)
+		}
+	}
+}
+
+func TestClamdScannerChunks(t *testing.T) {
+	clamd, clamdScanner := newFakeClamd(t, func(data []byte) string { return "stream: OK" })
+
+	file := bytes.Repeat([]byte("0123456789"), clamdChunkSize/4) //2.5 chunks
+	_, err := clamdScanner.Scan(context.Background(), bytes.NewReader(file))
+	if err != nil {
+		t.Fatalf("scan error %v", err)
+	}
+
+	if data := <-clamd.streams; !bytes.Equal(data, file) {
+		t.Errorf("clamd received %v bytes, want %v", len(data), len(file))
+	}
+	chunks := <-clamd.chunks
+	if len(chunks) != 3 || chunks[0] != clamdChunkSize || chunks[1] != clamdChunkSize || chunks[2] != len(file)-2*clamdChunkSize {
+		t.Errorf("chunks = %v", chunks)
+	}
+}
+
+func TestClamdScannerSizeLimit(t *testing.T) {
+	clamd, clamdScanner := newFakeClamd(t, func(data []byte) string { return "stream: OK" })
+	clamd.maxSize = clamdChunkSize
+
+	//the scanner is still sending the stream when clamd closes the connection
+	_, err := clamdScanner.Scan(context.Background(), bytes.NewReader(make([]byte, 64*clamdChunkSize)))
+	if !errors.Is(err, ScanLimitErr) {
+		t.Errorf("scan error = %v", err)
+	}
+}
+
+func TestClamdScannerPing(t *testing.T) {
+	_, clamdScanner := newFakeClamd(t, nil)
+
+	err := clamdScanner.Ping(context.Background())
+	if err != nil {
+		t.Errorf("ping error %v", err)
+	}
+}
+
+func TestClamdScannerUnavailable(t *testing.T) {
+	clamdScanner, err := NewClamdScanner("unix://"+filepath.Join(t.TempDir(), "missing.sock"), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = clamdScanner.Scan(context.Background(), strings.NewReader("file content"))
+	if !errors.Is(err, ScannerUnavailableErr) {
+		t.Errorf("scan error = %v", err)
+	}
+}
+
+func TestNewClamdScanner(t *testing.T) {
+	tests := []struct {
+		address string
+		network string
+		addr    string
+	}{
+		{"tcp://127.0.0.1:3310", "tcp", "127.0.0.1:3310"},
+		{"clamd:3310", "tcp", "clamd:3310"},
+		{"unix:///run/clamd.sock", "unix", "/run/clamd.sock"},
+	}
+
+	for _, test := range tests {
+		clamdScanner, err := NewClamdScanner(test.address, time.Second)
+		if err != nil {
+			t.Errorf("%v: error %v", test.address, err)
+			continue
+		}
+		if clamdScanner.network != test.network || clamdScanner.address != test.addr {
+			t.Errorf("%v: %v %v", test.address, clamdScanner.network, clamdScanner.address)
+		}
+	}
+
+	for _, address := range []string{"http://clamd:3310", "unix://", ""} {
+		_, err := NewClamdScanner(address, time.Second)
+		if err == nil {
+			t.Errorf("%v: no error", address)
+		}
+	}
+}
+
+func TestParseScanReply(t *testing.T) {
+	_, err := parseScanReply("INSTREAM size limit exceeded. ERROR")
+	if !errors.Is(err, ScanLimitErr) {
+		t.Errorf("size limit error = %v", err)
+	}
+}
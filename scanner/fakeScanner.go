@@ -0,0 +1,96 @@
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	// EICAR_TEST_FILE is the standard antivirus test file, detected by every scanner
+	EICAR_TEST_FILE      = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+	EICAR_TEST_SIGNATURE = "Eicar-Test-Signature"
+)
+
+// FakeScanner flags the files containing one of its Signatures (content -> name), the EICAR test file by default.
+// Files bigger than MaxSize fail with ScanLimitErr, Err fails every scan. The scanned files are counted
+type FakeScanner struct {
+	Signatures map[string]string
+	MaxSize    int64
+	Err        error
+
+	lock    sync.Mutex
+	scanned int
+}
+
+func NewFakeScanner() *FakeScanner {
+	return &FakeScanner{
+		Signatures: map[string]string{
+			EICAR_TEST_FILE: EICAR_TEST_SIGNATURE,
+		},
+	}
+}
+
+func (s *FakeScanner) Name() string {
+	return SCANNER_FAKE
+}
+
+func (s *FakeScanner) Scan(ctx context.Context, body io.Reader) (*ScanResult, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read file: %w", err)
+	}
+	s.scanned++
+
+	if s.MaxSize > 0 && int64(len(data)) > s.MaxSize {
+		return nil, fmt.Errorf("%w: %d > %d", ScanLimitErr, len(data), s.MaxSize)
+	}
+
+	for content, signature := range s.Signatures {
+		if bytes.Contains(data, []byte(content)) {
+			return &ScanResult{Infected: true, Signature: signature}, nil
+		}
+	}
+	return &ScanResult{}, nil
+}
+
+// Scanned returns the number of scanned files
+func (s *FakeScanner) Scanned() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.scanned
+}
+
+func (s *FakeScanner) Reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.scanned = 0
+	s.Err = nil
+}
+
+// NoopScanner reports every file as clean, used when the scans are disabled
+type NoopScanner struct {
+}
+
+func NewNoopScanner() *NoopScanner {
+	return &NoopScanner{}
+}
+
+func (s *NoopScanner) Name() string {
+	return SCANNER_NOOP
+}
+
+func (s *NoopScanner) Scan(ctx context.Context, body io.Reader) (*ScanResult, error) {
+	return &ScanResult{}, nil
+}
@@ -0,0 +1,79 @@
+package scanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	SCANNER_CLAMD = "clamd"
+	SCANNER_NOOP  = "noop"
+	SCANNER_FAKE  = "fake"
+
+	defaultClamdAddress = "tcp://127.0.0.1:3310"
+	defaultClamdTimeout = 2 * 60 //seconds
+)
+
+var (
+	ScanLimitErr          = errors.New("File exceeds the scan limits")
+	ScannerUnavailableErr = errors.New("Scanner unavailable")
+)
+
+// ScanResult - Signature is the name of the threat or of the violated policy found in an infected file
+type ScanResult struct {
+	Infected  bool
+	Signature string
+}
+
+// Scanner checks the content of the uploaded files. ScanLimitErr is returned for a file the scanner refuses to scan (size, archive depth),
+// ScannerUnavailableErr if the scanner can not be reached
+type Scanner interface {
+	Name() string
+	Scan(ctx context.Context, body io.Reader) (*ScanResult, error)
+}
+
+var (
+	singletonScanner Scanner
+	onceScanner      sync.Once
+)
+
+// GetScanner selects the scanner from SCANNER (clamd|noop|fake, default noop),
+// the clamd daemon is reached at CLAMD_ADDRESS (tcp://host:port or unix:///path, default tcp://127.0.0.1:3310) within CLAMD_TIMEOUT (seconds, default 2 mins)
+func GetScanner() Scanner {
+	onceScanner.Do(func() {
+		fmt.Println("Init Scanner...")
+
+		switch os.Getenv("SCANNER") {
+		case SCANNER_CLAMD:
+			address := os.Getenv("CLAMD_ADDRESS")
+			if len(address) == 0 {
+				address = defaultClamdAddress
+			}
+
+			timeout, err := strconv.ParseInt(os.Getenv("CLAMD_TIMEOUT"), 10, 64)
+			if err != nil || timeout <= 0 {
+				timeout = defaultClamdTimeout
+			}
+
+			clamdScanner, err := NewClamdScanner(address, time.Duration(timeout)*time.Second)
+			if err != nil {
+				log.Fatalf("Failed to init clamd Scanner: %v", err)
+			}
+			singletonScanner = clamdScanner
+		case SCANNER_FAKE:
+			singletonScanner = NewFakeScanner()
+		default:
+			singletonScanner = NewNoopScanner()
+		}
+
+		log.Println("Scanner:", singletonScanner.Name())
+	})
+	return singletonScanner
+}
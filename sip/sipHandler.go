@@ -151,6 +151,7 @@ func ParseAndInsertSignalMsgFromSipREQ(req *cwmSIPPb.CWMRequest, fromUser *model
 		ToSessionsWhiteList:   req.GetHeader().GetToSessionsWhiteList(),
 		ReceivedSessions:      receivedSessions,
 		ThreadType:            protoSignalMessage.GetThreadType(),
+		ImType:                protoSignalMessage.GetImType(),
 		DeleteForUsers:        []string{},
 		SeenByUsers:           []string{},
 		CwmData:               cwmData,
@@ -162,6 +163,9 @@ func ParseAndInsertSignalMsgFromSipREQ(req *cwmSIPPb.CWMRequest, fromUser *model
 		return nil, nil, 0, err
 	}
 
+	increaseUnread(signalThread, signalMsg)
+	indexThreadMedia(protoSignalMessage, signalMsg)
+
 	if protoSignalMessage.GetImType() == cwmSignalMsgPb.SIGNAL_IM_TYPE_SEENSTATE {
 		go handleSeenStateMsg(protoSignalMessage, signalMsg.From)
 	}
@@ -189,6 +193,7 @@ func handleSeenStateMsg(protoSignalMessage *cwmSignalMsgPb.SignalMessage, phoneF
 	if protoSignalSeenStateMessage.GetSeenStateType() == cwmSignalMsgPb.SIGNAL_SEENSTATE_MSG_TYPE_SEEN {
 		//log.Println("handleSeenStateMsg", protoSignalSeenStateMessage.GetMsgId())
 		dao.GetSignalMsgDAO().AppendSeenByUserByMsgIds(context.Background(), protoSignalSeenStateMessage.GetMsgId(), phoneFull)
+		RecountUnread(context.Background(), protoSignalMessage.GetThreadId(), []string{phoneFull})
 	}
 
 }
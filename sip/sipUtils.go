@@ -84,6 +84,62 @@ func CreateSignalEventMessageUpdateContactOTT(
 	return cwmRequest, nil
 }
 
+// CreateSignalEventMessageNotificationSettingsChanged syncs the user's notification settings to the sessions in receiverSessionsWhiteList
+func CreateSignalEventMessageNotificationSettingsChanged(
+	receiver string, receiverSessionsWhiteList []string,
+	user *model.User,
+) (*cwmSIPPb.CWMRequest, error) {
+	cwmRequestHeader := &cwmSIPPb.CWMRequestHeader{
+		Method:              cwmSIPPb.REQUEST_METHOD_METHOD_MESSAGE,
+		From:                static.ServerEventName,
+		To:                  receiver,
+		ToSessionsWhiteList: receiverSessionsWhiteList,
+	}
+
+	signalEventMessageNotificationSettingsChangedData, err := proto.Marshal(user.NotificationSettingsToProto())
+	if err != nil {
+		return nil, err
+	}
+
+	signalEventMessage := &cwmSignalMsgPb.SignalEventMessage{
+		EventType: cwmSignalMsgPb.SIGNAL_EVENT_MSG_TYPE_NOTIFICATION_SETTINGS_CHANGED,
+		Data:      signalEventMessageNotificationSettingsChangedData,
+	}
+
+	signalEventMessageData, err := proto.Marshal(signalEventMessage)
+	if err != nil {
+		return nil, err
+	}
+	checksum := fmt.Sprintf("%x", md5.Sum(signalEventMessageData))
+
+	threadId := utils.GetThreadId(static.ServerEventName, receiver)
+
+	now := time.Now()
+	imType := cwmSignalMsgPb.SIGNAL_IM_TYPE_EVENT
+	signalMessage := &cwmSignalMsgPb.SignalMessage{
+		ThreadId:   threadId,
+		MsgId:      utils.GenerateUUID(),
+		ThreadType: cwmSignalMsgPb.SIGNAL_THREAD_TYPE_SOLO,
+		ImType:     imType,
+		MsgDate:    now.UnixMilli(),
+		ServerDate: now.UnixMilli(),
+		Checksum:   checksum,
+		Data:       signalEventMessageData,
+	}
+
+	signalMessageData, err := proto.Marshal(signalMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	cwmRequest := &cwmSIPPb.CWMRequest{
+		Header:  cwmRequestHeader,
+		Content: signalMessageData,
+	}
+
+	return cwmRequest, nil
+}
+
 func CreateSignalEventMessageMsgDelete(sender *model.User, senderSessionID string, signalThread *model.SignalThread, msgIds []string, deleteForAllMembers bool) (*cwmSIPPb.CWMRequest, error) {
 	var receiver string
 	var threadType = signalThread.Type
@@ -0,0 +1,241 @@
+package sip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSIPPb"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"sol.go/cwm/proto/grpcCWMPb"
+	"time"
+)
+
+const (
+	backfillThreadMediaLockName  = "backfill:threadMedias"
+	backfillThreadMediaLockTTL   = 1 * time.Minute
+	backfillThreadMediaDoneKey   = "backfill:threadMedias:done"
+	backfillThreadMediaBatchSize = 500
+)
+
+// indexThreadMedia indexes the files and the url of a MULTIMEDIA / URL msg, or of a forwarded one, for the shared media of the thread
+func indexThreadMedia(protoSignalMessage *cwmSignalMsgPb.SignalMessage, signalMsg *model.SignalMsg) {
+	threadMedias, err := threadMediasOf(protoSignalMessage, signalMsg)
+	if err != nil {
+		log.Println("indexThreadMedia - invalid msg", signalMsg.MsgId, err)
+		return
+	}
+
+	for _, threadMedia := range threadMedias {
+		_, err = dao.GetThreadMediaDAO().Save(context.Background(), threadMedia)
+		if err != nil {
+			log.Println("indexThreadMedia - error", threadMedia.MediaKey, err)
+		}
+	}
+}
+
+func threadMediasOf(protoSignalMessage *cwmSignalMsgPb.SignalMessage, signalMsg *model.SignalMsg) ([]*model.ThreadMedia, error) {
+	imType, data := protoSignalMessage.GetImType(), protoSignalMessage.GetData()
+	if imType == cwmSignalMsgPb.SIGNAL_IM_TYPE_FORWARD {
+		protoForwardMessage := &cwmSignalMsgPb.SignalForwardMessage{}
+		err := proto.Unmarshal(data, protoForwardMessage)
+		if err != nil {
+			return nil, err
+		}
+		imType, data = protoForwardMessage.GetImType(), protoForwardMessage.GetData()
+	}
+
+	return parseThreadMedias(imType, data, signalMsg)
+}
+
+func parseThreadMedias(imType cwmSignalMsgPb.SIGNAL_IM_TYPE, data []byte, signalMsg *model.SignalMsg) ([]*model.ThreadMedia, error) {
+	threadMedias := []*model.ThreadMedia{}
+
+	switch imType {
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_MULTIMEDIA:
+		protoMultimediaMessage := &cwmSignalMsgPb.SignalMultimediaMessage{}
+		err := proto.Unmarshal(data, protoMultimediaMessage)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, fileInfo := range protoMultimediaMessage.GetMultimediaFileInfos() {
+			threadMedia := newThreadMedia(signalMsg, i, threadMediaType(fileInfo.GetMediaType()))
+			threadMedia.FileId = fileInfo.GetFileId()
+			threadMedia.FileName = fileInfo.GetFileName()
+			threadMedia.FileSize = fileInfo.GetFileSize()
+			threadMedia.FileType = fileInfo.GetMediaType()
+			threadMedia.MimeType = fileInfo.GetMimeType()
+			threadMedia.Checksum = fileInfo.GetChecksum()
+			threadMedias = append(threadMedias, threadMedia)
+		}
+	case cwmSignalMsgPb.SIGNAL_IM_TYPE_URL:
+		protoURLMessage := &cwmSignalMsgPb.SignalURLMessage{}
+		err := proto.Unmarshal(data, protoURLMessage)
+		if err != nil {
+			return nil, err
+		}
+		if len(protoURLMessage.GetUrl()) == 0 {
+			break
+		}
+
+		threadMedia := newThreadMedia(signalMsg, 0, grpcCWMPb.THREAD_MEDIA_TYPE_LINKS)
+		threadMedia.URL = protoURLMessage.GetUrl()
+		threadMedia.URLTitle = protoURLMessage.GetUrlTitle()
+		threadMedia.URLThumbnail = protoURLMessage.GetUrlThumbnail()
+		threadMedias = append(threadMedias, threadMedia)
+	}
+
+	return threadMedias, nil
+}
+
+func newThreadMedia(signalMsg *model.SignalMsg, index int, mediaType grpcCWMPb.THREAD_MEDIA_TYPE) *model.ThreadMedia {
+	return &model.ThreadMedia{
+		MediaKey:       fmt.Sprintf("%s:%d", signalMsg.MsgId, index),
+		ThreadId:       signalMsg.ThreadId,
+		MsgId:          signalMsg.MsgId,
+		Sender:         signalMsg.From,
+		MediaType:      mediaType,
+		DeleteForUsers: signalMsg.DeleteForUsers,
+		CreatedAt:      signalMsg.CreatedAt,
+	}
+}
+
+// threadMediaType - the tab of the shared media showing a file
+func threadMediaType(mediaType cwmSignalMsgPb.SIGNAL_MEDIA_TYPE) grpcCWMPb.THREAD_MEDIA_TYPE {
+	switch mediaType {
+	case cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_IMAGE, cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_VIDEO:
+		return grpcCWMPb.THREAD_MEDIA_TYPE_MEDIA
+	case cwmSignalMsgPb.SIGNAL_MEDIA_TYPE_AUDIO:
+		return grpcCWMPb.THREAD_MEDIA_TYPE_AUDIOS
+	default:
+		return grpcCWMPb.THREAD_MEDIA_TYPE_FILES
+	}
+}
+
+// DeleteThreadMediaOfMsgs hides the medias of the msgs for the phoneFulls, when the msgs are deleted
+func DeleteThreadMediaOfMsgs(ctx context.Context, msgIds []string, phoneFulls []string) error {
+	_, err := dao.GetThreadMediaDAO().AppendDeleteUsersByMsgIds(ctx, msgIds, phoneFulls)
+	return err
+}
+
+// DeleteThreadMediaOfThread hides all the medias of the thread for the phoneFulls, when the thread is cleared or deleted
+func DeleteThreadMediaOfThread(ctx context.Context, threadId string, phoneFulls []string) error {
+	_, err := dao.GetThreadMediaDAO().AppendDeleteUsersByThreadId(ctx, threadId, phoneFulls)
+	return err
+}
+
+// BackfillThreadMedia indexes the shared media of the msgs sent before the index, the files released by the media gc are skipped.
+// Only one node runs it, once
+func BackfillThreadMedia(ctx context.Context) {
+	cache := dao.GetCache()
+	done, err := cache.RedisClient.Exists(ctx, backfillThreadMediaDoneKey).Result()
+	if err != nil {
+		log.Println("BackfillThreadMedia - error", err)
+		return
+	}
+	if done > 0 {
+		return
+	}
+
+	signalMsgDAO := dao.GetSignalMsgDAO()
+	redLock := signalMsgDAO.CreateRedlockNoRetry(ctx, backfillThreadMediaLockName, backfillThreadMediaLockTTL)
+	err = redLock.Lock()
+	if err != nil {
+		return //running on another node
+	}
+	defer redLock.Unlock()
+
+	indexed := 0
+	lastOid := primitive.NilObjectID
+	for {
+		signalMsgs, err := signalMsgDAO.FindAllMediaMsgsAfter(ctx, lastOid, backfillThreadMediaBatchSize)
+		if err != nil {
+			log.Println("BackfillThreadMedia - error", err)
+			return
+		}
+		if len(signalMsgs) == 0 {
+			break
+		}
+
+		for _, signalMsg := range signalMsgs {
+			lastOid = signalMsg.ID
+			count, err := backfillThreadMediaOfMsg(ctx, signalMsg)
+			if err != nil {
+				log.Println("BackfillThreadMedia - error", signalMsg.MsgId, err)
+				return
+			}
+			indexed += count
+		}
+
+		_, err = redLock.ExtendContext(ctx)
+		if err != nil {
+			log.Println("BackfillThreadMedia - lost the lock", err)
+			return
+		}
+	}
+
+	err = cache.RedisClient.Set(ctx, backfillThreadMediaDoneKey, time.Now().UnixMilli(), 0).Err()
+	if err != nil {
+		log.Println("BackfillThreadMedia - error", err)
+	}
+	log.Println("BackfillThreadMedia - indexed", indexed)
+}
+
+func backfillThreadMediaOfMsg(ctx context.Context, signalMsg *model.SignalMsg) (int, error) {
+	cwmRequest := &cwmSIPPb.CWMRequest{}
+	err := proto.Unmarshal(signalMsg.CwmData, cwmRequest)
+	if err != nil {
+		return 0, nil //not indexable
+	}
+	protoSignalMessage := &cwmSignalMsgPb.SignalMessage{}
+	err = proto.Unmarshal(cwmRequest.GetContent(), protoSignalMessage)
+	if err != nil {
+		return 0, nil
+	}
+
+	threadMedias, err := threadMediasOf(protoSignalMessage, signalMsg)
+	if err != nil {
+		return 0, nil
+	}
+
+	count := 0
+	for _, threadMedia := range threadMedias {
+		if len(threadMedia.FileId) > 0 {
+			_, err = dao.GetS3FileInfoDAO().FindByFileId(ctx, threadMedia.FileId)
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				continue //released
+			}
+			if err != nil {
+				return count, err
+			}
+		}
+
+		err = dao.GetThreadMediaDAO().InsertIfMissing(ctx, threadMedia)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	//the msg may be deleted for more users since it was read
+	latestSignalMsg, err := dao.GetSignalMsgDAO().FindByMsgId(ctx, signalMsg.MsgId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return count, nil
+		}
+		return count, err
+	}
+	if len(latestSignalMsg.DeleteForUsers) > 0 {
+		err = DeleteThreadMediaOfMsgs(ctx, []string{signalMsg.MsgId}, latestSignalMsg.DeleteForUsers)
+	}
+	return count, err
+}
@@ -0,0 +1,178 @@
+package sip
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"sol.go/cwm/dao"
+	"sol.go/cwm/model"
+	"sol.go/cwm/proto/cwmSIPPb"
+	"sol.go/cwm/proto/cwmSignalMsgPb"
+	"time"
+)
+
+const (
+	recountUnreadTries = 3
+
+	backfillImTypeLockName  = "backfill:signalMsgs:imType"
+	backfillImTypeLockTTL   = 1 * time.Minute
+	backfillImTypeDoneKey   = "backfill:signalMsgs:imType:done"
+	backfillImTypeBatchSize = 500
+)
+
+// increaseUnread - a msg is unread for the thread's participants except the sender
+func increaseUnread(signalThread *model.SignalThread, signalMsg *model.SignalMsg) {
+	if signalMsg.ImType <= cwmSignalMsgPb.SIGNAL_IM_TYPE_EVENT {
+		return
+	}
+
+	receivers := []string{}
+	for _, participant := range signalThread.Participants {
+		if participant != signalMsg.From {
+			receivers = append(receivers, participant)
+		}
+	}
+
+	err := dao.GetUnreadCounterDAO().IncreaseByThreadId(context.Background(), signalThread.ThreadId, receivers, 1, signalMsg.CreatedAt)
+	if err != nil {
+		log.Println("increaseUnread - error", signalThread.ThreadId, err)
+	}
+}
+
+// RecountUnread recounts the unread msgs of the thread for the phoneFulls, after msgs are seen or deleted.
+// The recount is retried when a msg changed the counter meanwhile, the msgs created after the recount are counted by increaseUnread
+func RecountUnread(ctx context.Context, threadId string, phoneFulls []string) {
+	recounted := map[string]bool{}
+	for _, phoneFull := range phoneFulls {
+		if recounted[phoneFull] {
+			continue
+		}
+		recounted[phoneFull] = true
+
+		for try := 1; ; try++ {
+			updated, err := recountUnread(ctx, threadId, phoneFull)
+			if err != nil {
+				log.Println("RecountUnread - error", threadId, phoneFull, err)
+				break
+			}
+			if updated {
+				break
+			}
+			if try >= recountUnreadTries {
+				log.Println("RecountUnread - counter keeps changing, giving up", threadId, phoneFull)
+				break
+			}
+		}
+	}
+}
+
+func recountUnread(ctx context.Context, threadId string, phoneFull string) (bool, error) {
+	counter, err := dao.GetUnreadCounterDAO().FindByPhoneFullAndThreadId(ctx, phoneFull, threadId)
+	if err != nil {
+		return false, err
+	}
+
+	var version int64
+	if counter != nil {
+		version = counter.Version
+	}
+
+	recountedAt := time.Now().UnixMilli()
+	count, err := dao.GetSignalMsgDAO().CountUnreadOfThread(ctx, phoneFull, threadId, recountedAt)
+	if err != nil {
+		return false, err
+	}
+
+	return dao.GetUnreadCounterDAO().SetCount(ctx, phoneFull, threadId, count, recountedAt, version)
+}
+
+// ClearUnread removes the counters of the thread for the phoneFulls, when they are not in the thread anymore
+func ClearUnread(ctx context.Context, threadId string, phoneFulls []string) {
+	err := dao.GetUnreadCounterDAO().DeleteByThreadId(ctx, threadId, phoneFulls)
+	if err != nil {
+		log.Println("ClearUnread - error", threadId, err)
+	}
+}
+
+// BackfillImType stores the imType of the msgs saved before it was stored, the unread msgs are counted by it.
+// Only one node runs it, once
+func BackfillImType(ctx context.Context) {
+	cache := dao.GetCache()
+	done, err := cache.RedisClient.Exists(ctx, backfillImTypeDoneKey).Result()
+	if err != nil {
+		log.Println("BackfillImType - error", err)
+		return
+	}
+	if done > 0 {
+		return
+	}
+
+	signalMsgDAO := dao.GetSignalMsgDAO()
+	redLock := signalMsgDAO.CreateRedlockNoRetry(ctx, backfillImTypeLockName, backfillImTypeLockTTL)
+	err = redLock.Lock()
+	if err != nil {
+		return //running on another node
+	}
+	defer redLock.Unlock()
+
+	backfilled := 0
+	lastOid := primitive.NilObjectID
+	for {
+		signalMsgs, err := signalMsgDAO.FindAllWithoutImType(ctx, lastOid, backfillImTypeBatchSize)
+		if err != nil {
+			log.Println("BackfillImType - error", err)
+			return
+		}
+		if len(signalMsgs) == 0 {
+			break
+		}
+
+		imTypes := map[string]cwmSignalMsgPb.SIGNAL_IM_TYPE{}
+		for _, signalMsg := range signalMsgs {
+			lastOid = signalMsg.ID
+			imType, err := imTypeOf(signalMsg)
+			if err != nil {
+				log.Println("BackfillImType - skip", signalMsg.MsgId, err)
+				continue
+			}
+			imTypes[signalMsg.MsgId] = imType
+		}
+
+		err = signalMsgDAO.SetImTypes(ctx, imTypes)
+		if err != nil {
+			log.Println("BackfillImType - error", err)
+			return
+		}
+		backfilled += len(imTypes)
+
+		_, err = redLock.ExtendContext(ctx)
+		if err != nil {
+			log.Println("BackfillImType - lost the lock", err)
+			return
+		}
+	}
+
+	err = cache.RedisClient.Set(ctx, backfillImTypeDoneKey, time.Now().UnixMilli(), 0).Err()
+	if err != nil {
+		log.Println("BackfillImType - error", err)
+	}
+	log.Println("BackfillImType - backfilled", backfilled)
+}
+
+// imTypeOf reads the imType from the stored request
+func imTypeOf(signalMsg *model.SignalMsg) (cwmSignalMsgPb.SIGNAL_IM_TYPE, error) {
+	cwmRequest := &cwmSIPPb.CWMRequest{}
+	err := proto.Unmarshal(signalMsg.CwmData, cwmRequest)
+	if err != nil {
+		return 0, err
+	}
+
+	protoSignalMessage := &cwmSignalMsgPb.SignalMessage{}
+	err = proto.Unmarshal(cwmRequest.GetContent(), protoSignalMessage)
+	if err != nil {
+		return 0, err
+	}
+
+	return protoSignalMessage.GetImType(), nil
+}
@@ -12,8 +12,14 @@ const (
 	NONCETTL                        = 20 //60 mins
 	JWTTTL                          = 20 //60 mins
 	//MaxFileSize = 1 << 10 //1 KB
-	MaxFileSize   = 150 * 1024 << 10 //150 MB
-	S3NamePrefxix = "cwm_ttl_"
+	MaxFileSize        = 150 * 1024 << 10 //150 MB
+	S3NamePrefxix      = "cwm_ttl_"
+	MaxAvatarSize      = 10 * 1024 << 10   //10 MB
+	S3AvatarPrefix     = "cwm_avatar_"     //not expired like the media msgs
+	AvatarMsgPrefix    = "avatar_"         //S3FileInfo.MsgId of an avatar is AvatarMsgPrefix + phoneFull
+	S3QuarantinePrefix = "cwm_quarantine_" //blobs blocked by the content scan, never served
+
+	ShutdownTimeout = 30 //30 sec
 )
 
 func JWTKey() []byte {
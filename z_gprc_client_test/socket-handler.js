@@ -41,7 +41,7 @@ function initSocket(username, phone) {
 
     socket = io("http://localhost:9000", {
         path: '/ws',
-        transports: ['websocket'],
+        transports: ['polling', 'websocket'], //start with polling, upgrade to websocket when possible
         query: {
             username: username,
             phone: phone,